@@ -0,0 +1,146 @@
+// Package codec binds and renders protobuf messages as JSON the way the
+// protobuf contract defines it: fields use their proto names, unpopulated
+// fields are always emitted and requests with unknown fields are rejected.
+package codec
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/render"
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const contentType = "application/json; charset=utf-8"
+
+// ErrEmptyBody is returned by Bind when the request carries no body
+var ErrEmptyBody = errors.New("request body is empty")
+
+var (
+	marshalOptions = protojson.MarshalOptions{
+		UseProtoNames:   true,
+		EmitUnpopulated: true,
+	}
+	unmarshalOptions = protojson.UnmarshalOptions{
+		DiscardUnknown: false,
+	}
+)
+
+// Marshal encodes m with the shared protojson options
+func Marshal(m proto.Message) ([]byte, error) {
+	return marshalOptions.Marshal(proto.MessageV2(m))
+}
+
+// Unmarshal decodes data into m, unknown fields are an error
+func Unmarshal(data []byte, m proto.Message) error {
+	return unmarshalOptions.Unmarshal(data, proto.MessageV2(m))
+}
+
+// Bind decodes the request body into m
+func Bind(c *gin.Context, m proto.Message) error {
+	if c.Request.Body == nil {
+		return ErrEmptyBody
+	}
+
+	data, err := ioutil.ReadAll(c.Request.Body)
+	if err != nil {
+		return err
+	}
+	if len(data) == 0 {
+		return ErrEmptyBody
+	}
+
+	return Unmarshal(data, m)
+}
+
+// Render writes m as the response body with the given status
+func Render(c *gin.Context, code int, m proto.Message) {
+	c.Render(code, JSON{Message: m})
+}
+
+// JSON is a gin render.Render for protobuf messages
+type JSON struct {
+	Message proto.Message
+}
+
+// Render implements render.Render
+func (r JSON) Render(w http.ResponseWriter) error {
+	r.WriteContentType(w)
+
+	data, err := Marshal(r.Message)
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Write(data)
+	return err
+}
+
+// WriteContentType implements render.Render
+func (r JSON) WriteContentType(w http.ResponseWriter) {
+	header := w.Header()
+	if val := header["Content-Type"]; len(val) == 0 {
+		header["Content-Type"] = []string{contentType}
+	}
+}
+
+// Merge encodes m and adds the extra top level fields to it, extra fields
+// are encoded with encoding/json
+func Merge(m proto.Message, extra map[string]interface{}) (json.RawMessage, error) {
+	data, err := Marshal(m)
+	if err != nil || len(extra) == 0 {
+		return data, err
+	}
+
+	fields := make(map[string]json.RawMessage, len(extra))
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	for name, value := range extra {
+		if fields[name], err = json.Marshal(value); err != nil {
+			return nil, err
+		}
+	}
+
+	return json.Marshal(fields)
+}
+
+// RenderRaw writes data, already encoded by Marshal or Merge, as the
+// response body with the given status
+func RenderRaw(c *gin.Context, code int, data []byte) {
+	c.Render(code, render.Data{ContentType: contentType, Data: data})
+}
+
+// RenderEnvelope writes m with the extra top level fields merged in
+func RenderEnvelope(c *gin.Context, code int, m proto.Message, extra map[string]interface{}) {
+	c.Render(code, Envelope{Message: m, Extra: extra})
+}
+
+// Envelope is a gin render.Render for a protobuf message extended with
+// fields that aren't part of its contract
+type Envelope struct {
+	Message proto.Message
+	Extra   map[string]interface{}
+}
+
+// Render implements render.Render
+func (r Envelope) Render(w http.ResponseWriter) error {
+	r.WriteContentType(w)
+
+	data, err := Merge(r.Message, r.Extra)
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Write(data)
+	return err
+}
+
+// WriteContentType implements render.Render
+func (r Envelope) WriteContentType(w http.ResponseWriter) {
+	JSON{}.WriteContentType(w)
+}
@@ -1,9 +1,11 @@
 package models
 
 type Author struct {
-	Name string `json:"name"`
+	Name string `json:"name" binding:"required,max=255"`
 }
 
 type ListAuthors struct {
-	Authors []Author `json:"authors"`
+	Authors    []Author   `json:"authors"`
+	NextCursor string     `json:"next_cursor"`
+	Pagination Pagination `json:"pagination"`
 }
@@ -1,15 +1,15 @@
 package models
 
 type Book struct {
-	Name        string   `json:"name"`
-	AuthorId    string   `json:"author_id"`
-	CategoryIds []string `json:"category_id"`
+	Name        string   `json:"name" binding:"required,max=255"`
+	AuthorId    string   `json:"author_id" binding:"required,uuid"`
+	CategoryIds []string `json:"category_id" binding:"max=20,dive,uuid"`
 }
 
 type UpdateBook struct {
-	Name        string   `json:"name"`
-	AuthorId    string   `json:"author_id"`
-	CategoryIds []string `json:"category_id"`
+	Name        string   `json:"name" binding:"required,max=255"`
+	AuthorId    string   `json:"author_id" binding:"required,uuid"`
+	CategoryIds []string `json:"category_id" binding:"max=20,dive,uuid"`
 }
 
 type BookById struct {
@@ -17,5 +17,7 @@ type BookById struct {
 }
 
 type ListBooks struct {
-	Books []Book `json:"Books"`
+	Books      []Book     `json:"books"`
+	NextCursor string     `json:"next_cursor"`
+	Pagination Pagination `json:"pagination"`
 }
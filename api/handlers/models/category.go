@@ -1,10 +1,27 @@
 package models
 
 type Category struct {
-	Name     string `json:"name"`
-	ParentId string `json:"parent_id"`
+	Name     string `json:"name" binding:"required,max=255"`
+	ParentId string `json:"parent_id" binding:"omitempty,uuid"`
 }
 
 type ListCategories struct {
 	Categories []Category `json:"categories"`
+	NextCursor string     `json:"next_cursor"`
+	Pagination Pagination `json:"pagination"`
+}
+
+// CategoryNode is a category with its subcategories nested in Children
+type CategoryNode struct {
+	Id       string          `json:"id"`
+	Name     string          `json:"name"`
+	ParentId string          `json:"parent_id"`
+	Children []*CategoryNode `json:"children"`
+}
+
+type CategoryTree struct {
+	Categories []*CategoryNode `json:"categories"`
+	// Truncated is set when the catalog has more categories than the tree
+	// is built from
+	Truncated bool `json:"truncated"`
 }
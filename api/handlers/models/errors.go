@@ -1,8 +1,37 @@
 package models
 
+// Machine readable error codes returned in Error.Code
+const (
+	ErrCodeInvalidArgument      = "INVALID_ARGUMENT"
+	ErrCodeValidationFailed     = "VALIDATION_FAILED"
+	ErrCodeNotFound             = "NOT_FOUND"
+	ErrCodeAlreadyExists        = "ALREADY_EXISTS"
+	ErrCodeConflict             = "CONFLICT"
+	ErrCodePreconditionFailed   = "PRECONDITION_FAILED"
+	ErrCodePreconditionRequired = "PRECONDITION_REQUIRED"
+	ErrCodePayloadTooLarge      = "PAYLOAD_TOO_LARGE"
+	ErrCodeUnauthenticated      = "UNAUTHENTICATED"
+	ErrCodePermissionDenied     = "PERMISSION_DENIED"
+	ErrCodeResourceExhausted    = "RESOURCE_EXHAUSTED"
+	ErrCodeCanceled             = "CANCELED"
+	ErrCodeUnimplemented        = "UNIMPLEMENTED"
+	ErrCodeUnavailable          = "UNAVAILABLE"
+	ErrCodeDeadlineExceeded     = "DEADLINE_EXCEEDED"
+	ErrCodeInternal             = "INTERNAL"
+)
+
 // Error ...
 type Error struct {
-	Message string `json:"message"`
+	Code      string        `json:"code"`
+	Message   string        `json:"message"`
+	RequestID string        `json:"request_id,omitempty"`
+	Details   []ErrorDetail `json:"details,omitempty"`
+}
+
+// ErrorDetail describes a single violation, usually bound to a request field
+type ErrorDetail struct {
+	Field       string `json:"field,omitempty"`
+	Description string `json:"description"`
 }
 
 // StandardErrorModel ...
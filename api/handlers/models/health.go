@@ -0,0 +1,19 @@
+package models
+
+// Health statuses
+const (
+	HealthStatusOK          = "ok"
+	HealthStatusUnavailable = "unavailable"
+)
+
+type Health struct {
+	Status       string                      `json:"status"`
+	Dependencies map[string]DependencyHealth `json:"dependencies,omitempty"`
+}
+
+type DependencyHealth struct {
+	Status  string `json:"status"`
+	State   string `json:"state"`
+	Serving string `json:"serving,omitempty"`
+	Error   string `json:"error,omitempty"`
+}
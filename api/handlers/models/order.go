@@ -1,10 +1,40 @@
 package models
 
 type Order struct {
-	BookId      string `json:"book_id"`
-	Description string `json:"description"`
+	BookId      string `json:"book_id" binding:"required,uuid"`
+	Description string `json:"description" binding:"max=1000"`
 }
 
 type ListOrders struct {
-	Orders []Order `json:"orders"`
+	Orders     []Order    `json:"orders"`
+	NextCursor string     `json:"next_cursor"`
+	Pagination Pagination `json:"pagination"`
+}
+
+// Order statuses
+const (
+	OrderStatusPending   = "pending"
+	OrderStatusPaid      = "paid"
+	OrderStatusShipped   = "shipped"
+	OrderStatusDelivered = "delivered"
+	OrderStatusCancelled = "cancelled"
+	OrderStatusRefunded  = "refunded"
+)
+
+// OrderTransition is the optional body of the order status actions
+type OrderTransition struct {
+	Reason string `json:"reason" binding:"max=500"`
+}
+
+// OrderStatusChange is an entry of the status history of an order
+type OrderStatusChange struct {
+	FromStatus string `json:"from_status"`
+	ToStatus   string `json:"to_status"`
+	ChangedBy  string `json:"changed_by"`
+	Reason     string `json:"reason"`
+	ChangedAt  string `json:"changed_at"`
+}
+
+type OrderStatusHistory struct {
+	Changes []OrderStatusChange `json:"changes"`
 }
@@ -0,0 +1,11 @@
+package models
+
+// Pagination is returned next to the items of every list response, Page is
+// omitted when the listing is walked with a cursor
+type Pagination struct {
+	Page       int64 `json:"page,omitempty"`
+	Limit      int64 `json:"limit"`
+	Total      int64 `json:"total"`
+	TotalPages int64 `json:"total_pages"`
+	HasNext    bool  `json:"has_next"`
+}
@@ -3,14 +3,14 @@ package v1
 import (
 	"context"
 	"net/http"
-	"time"
 
 	"github.com/gin-gonic/gin"
-	"google.golang.org/protobuf/encoding/protojson"
+	"github.com/gogo/protobuf/types"
 
-	_ "github.com/muhriddinsalohiddin/online_store_api/api/handlers/models"
+	"github.com/muhriddinsalohiddin/online_store_api/api/codec"
+	"github.com/muhriddinsalohiddin/online_store_api/api/handlers/models"
 	pb "github.com/muhriddinsalohiddin/online_store_api/genproto/catalog_service"
-	l "github.com/muhriddinsalohiddin/online_store_api/pkg/logger"
+	"github.com/muhriddinsalohiddin/online_store_api/pkg/auth"
 	"github.com/muhriddinsalohiddin/online_store_api/pkg/utils"
 )
 
@@ -18,41 +18,37 @@ import (
 // @Summary CreateAuthor
 // @Description This API for creating a new author
 // @Tags author
+// @Security BearerAuth
 // @Accept  json
 // @Produce  json
 // @Param Author request body models.Author true "authorCreateRequest"
+// @Param Idempotency-Key header string false "Key making retries of the request safe"
 // @Success 200 {object} models.Author
 // @Failure 400 {object} models.StandardErrorModel
+// @Failure 401 {object} models.StandardErrorModel
+// @Failure 403 {object} models.StandardErrorModel
+// @Failure 409 {object} models.StandardErrorModel
+// @Failure 413 {object} models.StandardErrorModel
+// @Failure 422 {object} models.StandardErrorModel
 // @Failure 500 {object} models.StandardErrorModel
 // @Router /v1/authors/ [post]
 func (h *handlerV1) CreateAuthor(c *gin.Context) {
-	var (
-		body        pb.Author
-		jspbMarshal protojson.MarshalOptions
-	)
-	jspbMarshal.UseProtoNames = true
-
-	err := c.ShouldBindJSON(&body)
-	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{
-			"error": err.Error(),
-		})
-		h.log.Error("failed to bind json", l.Error(err))
+	var body models.Author
+	if !h.bindJSON(c, &pb.Author{}, &body) {
 		return
 	}
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.CtxTimeout))
+	ctx, cancel := h.requestContext(c)
 	defer cancel()
 
-	response, err := h.serviceManager.CatalogService().CreateAuthor(ctx, &body)
+	response, err := h.serviceManager.CatalogService().CreateAuthor(ctx, &pb.Author{
+		Name: body.Name,
+	})
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error": err.Error(),
-		})
-		h.log.Error("failed to create author", l.Error(err))
+		h.handleGRPCError(c, err, "failed to create author")
 		return
 	}
 
-	c.JSON(http.StatusCreated, response)
+	codec.Render(c, http.StatusCreated, response)
 }
 
 // GetAuthor ...
@@ -62,16 +58,17 @@ func (h *handlerV1) CreateAuthor(c *gin.Context) {
 // @Accept  json
 // @Produce  json
 // @Param id path string true "ID"
+// @Param If-None-Match header string false "ETag of the copy the client holds"
 // @Success 200 {object} models.Author
+// @Header 200 {string} ETag "Version of the resource"
+// @Success 304 "Not Modified"
 // @Failure 400 {object} models.StandardErrorModel
+// @Failure 404 {object} models.StandardErrorModel
 // @Failure 500 {object} models.StandardErrorModel
 // @Router /v1/authors/{id} [get]
 func (h *handlerV1) GetAuthor(c *gin.Context) {
-	var jspbMarshal protojson.MarshalOptions
-	jspbMarshal.UseProtoNames = true
-
 	guid := c.Param("id")
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.CtxTimeout))
+	ctx, cancel := h.requestContext(c)
 	defer cancel()
 
 	response, err := h.serviceManager.CatalogService().GetAuthorById(
@@ -79,14 +76,16 @@ func (h *handlerV1) GetAuthor(c *gin.Context) {
 			Id: guid,
 		})
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error": err.Error(),
-		})
-		h.log.Error("failed to get author", l.Error(err))
+		h.handleGRPCError(c, err, "failed to get author")
 		return
 	}
 
-	c.JSON(http.StatusOK, response)
+	setETag(c, response)
+	if notModified(c, response) {
+		return
+	}
+
+	codec.Render(c, http.StatusOK, response)
 }
 
 // ListAuthors ...
@@ -97,115 +96,221 @@ func (h *handlerV1) GetAuthor(c *gin.Context) {
 // @Produce  json
 // @Param page query string false "Page"
 // @Param limit query string false "Limit"
+// @Param cursor query string false "Cursor from next_cursor of the previous page, replaces page"
+// @Param name_prefix query string false "Name prefix"
+// @Param deleted query string false "Whether soft deleted items are listed, include and only need write access to the catalog" Enums(exclude, include, only)
 // @Success 200 {object} models.ListAuthors
+// @Header 200 {string} Link "RFC 8288 links to the first, prev, next and last pages"
+// @Header 200 {integer} X-Total-Count "Total number of items"
 // @Failure 400 {object} models.StandardErrorModel
+// @Failure 401 {object} models.StandardErrorModel
+// @Failure 403 {object} models.StandardErrorModel
 // @Failure 500 {object} models.StandardErrorModel
 // @Router /v1/authors [get]
 func (h *handlerV1) ListAuthors(c *gin.Context) {
 	queryParams := c.Request.URL.Query()
 
 	params, errStr := utils.ParseQueryParams(queryParams)
+	cursor, pagingErrs := h.parsePaging(c, "authors", params)
+	errStr = append(errStr, pagingErrs...)
+	filters := filterParser{query: queryParams}
+	namePrefix := filters.prefix("name_prefix")
+	deleted := filters.oneOf("deleted", deletedScopes)
+	errStr = append(errStr, filters.errStr...)
 	if errStr != nil {
-		c.JSON(http.StatusBadRequest, gin.H{
-			"error": errStr[0],
-		})
-		h.log.Error("failed to parse query params json" + errStr[0])
+		h.handleInvalidQueryParams(c, errStr)
+		return
+	}
+	if !h.allowDeleted(c, deleted, auth.PermCatalogWrite) {
 		return
 	}
 
-	var jspbMarshal protojson.MarshalOptions
-	jspbMarshal.UseProtoNames = true
-
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.CtxTimeout))
+	ctx, cancel := h.requestContext(c)
 	defer cancel()
 
 	response, err := h.serviceManager.CatalogService().ListAuthors(
 		ctx, &pb.ListAuthorReq{
-			Limit: params.Limit,
-			Page:  params.Page,
+			Limit:      params.Limit,
+			Page:       params.Page,
+			Cursor:     cursor,
+			NamePrefix: namePrefix,
+			Deleted:    deleted,
 		})
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error": err.Error(),
-		})
-		h.log.Error("failed to list authors", l.Error(err))
+		h.handleGRPCError(c, err, "failed to list authors")
 		return
 	}
 
-	c.JSON(http.StatusOK, response)
+	response.NextCursor = h.nextCursor(c, "authors", response.NextCursor)
+	h.renderList(c, params, response, nil)
 }
 
 // UpdateAuthor ...
 // @Summary UpdateAuthor
 // @Description This API for updating author
 // @Tags author
+// @Security BearerAuth
 // @Accept  json
 // @Produce  json
 // @Param id path string true "ID"
+// @Param If-Match header string false "ETag of the version the change is based on"
 // @Param Author request body models.Author true "authorUpdateRequest"
 // @Success 200
 // @Failure 400 {object} models.StandardErrorModel
+// @Failure 401 {object} models.StandardErrorModel
+// @Failure 403 {object} models.StandardErrorModel
+// @Failure 404 {object} models.StandardErrorModel
+// @Failure 422 {object} models.StandardErrorModel
+// @Failure 412 {object} models.StandardErrorModel
+// @Failure 428 {object} models.StandardErrorModel
 // @Failure 500 {object} models.StandardErrorModel
 // @Router /v1/authors/{id} [put]
 func (h *handlerV1) UpdateAuthor(c *gin.Context) {
-	var (
-		body        pb.Author
-		jspbMarshal protojson.MarshalOptions
-	)
-	jspbMarshal.UseProtoNames = true
+	var body models.Author
+	if !h.bindJSON(c, &pb.Author{}, &body) {
+		return
+	}
 
-	err := c.ShouldBindJSON(&body)
+	ctx, cancel := h.requestContext(c)
+	defer cancel()
+
+	expected, ok := h.checkIfMatch(ctx, c, "author", c.Param("id"))
+	if !ok {
+		return
+	}
+
+	response, err := h.serviceManager.CatalogService().UpdateAuthor(ctx, &pb.Author{
+		Id:        c.Param("id"),
+		Name:      body.Name,
+		UpdatedAt: expected,
+	})
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{
-			"error": err.Error(),
-		})
-		h.log.Error("failed to bind json", l.Error(err))
+		h.handleGRPCError(c, err, "failed to update author")
 		return
 	}
+	setETag(c, response)
+	codec.Render(c, http.StatusOK, response)
+}
 
-	body.Id = c.Param("id")
+// PatchAuthor ...
+// @Summary PatchAuthor
+// @Description This API for partially updating author, only the fields in the RFC 7396 merge patch change
+// @Tags author
+// @Security BearerAuth
+// @Accept  application/merge-patch+json
+// @Produce  json
+// @Param id path string true "ID"
+// @Param If-Match header string false "ETag of the version the change is based on"
+// @Param Author request body models.Author true "authorPatchRequest"
+// @Success 200
+// @Header 200 {string} ETag "Version of the resource"
+// @Failure 400 {object} models.StandardErrorModel
+// @Failure 401 {object} models.StandardErrorModel
+// @Failure 403 {object} models.StandardErrorModel
+// @Failure 404 {object} models.StandardErrorModel
+// @Failure 412 {object} models.StandardErrorModel
+// @Failure 415 {object} models.StandardErrorModel
+// @Failure 422 {object} models.StandardErrorModel
+// @Failure 428 {object} models.StandardErrorModel
+// @Failure 500 {object} models.StandardErrorModel
+// @Router /v1/authors/{id} [patch]
+func (h *handlerV1) PatchAuthor(c *gin.Context) {
+	author := &pb.Author{}
+	var body models.Author
+	paths, ok := h.bindMergePatch(c, author, &body)
+	if !ok {
+		return
+	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.CtxTimeout))
+	ctx, cancel := h.requestContext(c)
 	defer cancel()
 
-	response, err := h.serviceManager.CatalogService().UpdateAuthor(ctx, &body)
+	expected, ok := h.checkIfMatch(ctx, c, "author", c.Param("id"))
+	if !ok {
+		return
+	}
+
+	author.Id = c.Param("id")
+	author.UpdatedAt = expected
+	response, err := h.serviceManager.CatalogService().PatchAuthor(ctx, &pb.PatchAuthorReq{
+		Author:     author,
+		UpdateMask: &types.FieldMask{Paths: paths},
+	})
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error": err.Error(),
-		})
-		h.log.Error("failed to update author", l.Error(err))
+		h.handleGRPCError(c, err, "failed to patch author")
 		return
 	}
-	c.JSON(http.StatusOK, response)
+	setETag(c, response)
+	codec.Render(c, http.StatusOK, response)
 }
 
 // DeleteAuthor ...
 // @Summary DeleteAuthor
 // @Description This API for deleting author
 // @Tags author
+// @Security BearerAuth
 // @Accept  json
 // @Produce  json
 // @Param id path string true "ID"
+// @Param If-Match header string false "ETag of the version the change is based on"
 // @Success 200
 // @Failure 400 {object} models.StandardErrorModel
+// @Failure 401 {object} models.StandardErrorModel
+// @Failure 403 {object} models.StandardErrorModel
+// @Failure 404 {object} models.StandardErrorModel
+// @Failure 412 {object} models.StandardErrorModel
+// @Failure 428 {object} models.StandardErrorModel
 // @Failure 500 {object} models.StandardErrorModel
 // @Router /v1/authors/{id} [delete]
 func (h *handlerV1) DeleteAuthor(c *gin.Context) {
-	var jspbMarshal protojson.MarshalOptions
-	jspbMarshal.UseProtoNames = true
-
 	guid := c.Param("id")
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.CtxTimeout))
+	ctx, cancel := h.requestContext(c)
 	defer cancel()
 
+	expected, ok := h.checkIfMatch(ctx, c, "author", guid)
+	if !ok {
+		return
+	}
+
 	response, err := h.serviceManager.CatalogService().DeleteAuthorById(
-		ctx, &pb.GetAuthorByIdReq{Id: guid})
+		ctx, &pb.GetAuthorByIdReq{Id: guid, ExpectedUpdatedAt: expected})
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error": err.Error(),
-		})
-		h.log.Error("failed to delete author", l.Error(err))
+		h.handleGRPCError(c, err, "failed to delete author")
 		return
 	}
-	c.JSON(http.StatusOK, response)
+	codec.Render(c, http.StatusOK, response)
+}
+
+// ListAuthorBooks ...
+// @Summary ListAuthorBooks
+// @Description This API for getting list of books of an author
+// @Tags author
+// @Accept  json
+// @Produce  json
+// @Param id path string true "ID"
+// @Param page query string false "Page"
+// @Param limit query string false "Limit"
+// @Param cursor query string false "Cursor from next_cursor of the previous page, replaces page"
+// @Param search query string false "Matches book and author names"
+// @Param ordering query string false "Comma separated sort fields, '-' prefix for descending: name, created_at, updated_at"
+// @Param expand query string false "Comma separated related entities to embed: author, categories"
+// @Param deleted query string false "Whether soft deleted items are listed, include and only need write access to the catalog" Enums(exclude, include, only)
+// @Success 200 {object} models.ListBooks
+// @Header 200 {string} Link "RFC 8288 links to the first, prev, next and last pages"
+// @Header 200 {integer} X-Total-Count "Total number of items"
+// @Failure 400 {object} models.StandardErrorModel
+// @Failure 401 {object} models.StandardErrorModel
+// @Failure 403 {object} models.StandardErrorModel
+// @Failure 404 {object} models.StandardErrorModel
+// @Failure 500 {object} models.StandardErrorModel
+// @Router /v1/authors/{id}/books [get]
+func (h *handlerV1) ListAuthorBooks(c *gin.Context) {
+	id := c.Param("id")
+
+	h.listBooks(c, "authors/"+id+"/books", &pb.ListBookReq{AuthorId: id}, nil,
+		func(ctx context.Context, _ *pb.ListBookReq) error {
+			// an unknown author would otherwise just have no books
+			_, err := h.serviceManager.CatalogService().GetAuthorById(ctx, &pb.GetAuthorByIdReq{Id: id})
+			return err
+		})
 }
@@ -3,14 +3,15 @@ package v1
 import (
 	"context"
 	"net/http"
-	"time"
+	"strings"
 
 	"github.com/gin-gonic/gin"
-	"google.golang.org/protobuf/encoding/protojson"
+	"github.com/gogo/protobuf/types"
 
-	_ "github.com/muhriddinsalohiddin/online_store_api/api/handlers/models"
+	"github.com/muhriddinsalohiddin/online_store_api/api/codec"
+	"github.com/muhriddinsalohiddin/online_store_api/api/handlers/models"
 	pb "github.com/muhriddinsalohiddin/online_store_api/genproto/catalog_service"
-	l "github.com/muhriddinsalohiddin/online_store_api/pkg/logger"
+	"github.com/muhriddinsalohiddin/online_store_api/pkg/auth"
 	"github.com/muhriddinsalohiddin/online_store_api/pkg/utils"
 )
 
@@ -18,39 +19,43 @@ import (
 // @Summary CreateBook
 // @Description This API for creating a new book
 // @Tags book
+// @Security BearerAuth
 // @Accept  json
 // @Produce  json
 // @Param Book request body models.Book true "bookCreateRequest"
+// @Param Idempotency-Key header string false "Key making retries of the request safe"
 // @Success 200 {object} models.Book
 // @Failure 400 {object} models.StandardErrorModel
+// @Failure 401 {object} models.StandardErrorModel
+// @Failure 403 {object} models.StandardErrorModel
+// @Failure 409 {object} models.StandardErrorModel
+// @Failure 413 {object} models.StandardErrorModel
+// @Failure 422 {object} models.StandardErrorModel
 // @Failure 500 {object} models.StandardErrorModel
 // @Router /v1/books/ [post]
 func (h *handlerV1) CreateBook(c *gin.Context) {
-	var (
-		body        pb.Book
-		jspbMarshal protojson.MarshalOptions
-	)
-	jspbMarshal.UseProtoNames = true
-
-	err := c.ShouldBindJSON(&body)
-	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{
-			"error": err.Error(),
-		})
-		h.log.Error("failed to bind json", l.Error(err))
+	var body models.Book
+	if !h.bindJSON(c, &pb.Book{}, &body) {
 		return
 	}
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.CtxTimeout))
+	ctx, cancel := h.requestContext(c)
 	defer cancel()
-	response, err := h.serviceManager.CatalogService().CreateBook(ctx, &body)
+
+	refs := append([]reference{authorRef("author_id", body.AuthorId)}, categoryRefs("category_id", body.CategoryIds)...)
+	if !h.checkReferences(ctx, c, refs...) {
+		return
+	}
+
+	response, err := h.serviceManager.CatalogService().CreateBook(ctx, &pb.Book{
+		Name:       body.Name,
+		AuthorId:   body.AuthorId,
+		CategoryId: body.CategoryIds,
+	})
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error": err.Error(),
-		})
-		h.log.Error("failed to create Book", l.Error(err))
+		h.handleGRPCError(c, err, "failed to create Book")
 		return
 	}
-	c.JSON(http.StatusCreated, response)
+	codec.Render(c, http.StatusCreated, response)
 }
 
 // GetBook ...
@@ -60,16 +65,25 @@ func (h *handlerV1) CreateBook(c *gin.Context) {
 // @Accept  json
 // @Produce  json
 // @Param id path string true "ID"
+// @Param If-None-Match header string false "ETag of the copy the client holds"
+// @Param expand query string false "Comma separated related entities to embed: author, categories"
 // @Success 200 {object} models.Book
+// @Header 200 {string} ETag "Version of the resource, a weak tag of the body when expand is set"
+// @Success 304 "Not Modified"
 // @Failure 400 {object} models.StandardErrorModel
+// @Failure 404 {object} models.StandardErrorModel
 // @Failure 500 {object} models.StandardErrorModel
 // @Router /v1/books/{id} [get]
 func (h *handlerV1) GetBookById(c *gin.Context) {
-	var jspbMarshal protojson.MarshalOptions
-	jspbMarshal.UseProtoNames = true
-
 	guid := c.Param("id")
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.CtxTimeout))
+	filters := filterParser{query: c.Request.URL.Query()}
+	expand := filters.expand(bookExpansions)
+	if filters.errStr != nil {
+		h.handleInvalidQueryParams(c, filters.errStr)
+		return
+	}
+
+	ctx, cancel := h.requestContext(c)
 	defer cancel()
 
 	response, err := h.serviceManager.CatalogService().GetBookById(
@@ -77,92 +91,178 @@ func (h *handlerV1) GetBookById(c *gin.Context) {
 			Id: guid,
 		})
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error": err.Error(),
-		})
-		h.log.Error("failed to get Book", l.Error(err))
+		h.handleGRPCError(c, err, "failed to get Book")
 		return
 	}
 
-	c.JSON(http.StatusOK, response)
+	if len(expand) == 0 {
+		setETag(c, response)
+		if !notModified(c, response) {
+			codec.Render(c, http.StatusOK, response)
+		}
+		return
+	}
+
+	fields, err := h.expandBooks(ctx, expand, []*pb.Book{response})
+	if err != nil {
+		h.handleGRPCError(c, err, "failed to expand Book")
+		return
+	}
+	h.renderExpanded(c, response, fields[0])
 }
 
 // UpdateBook ...
 // @Summary UpdateBook
 // @Description This API for updating book
 // @Tags book
+// @Security BearerAuth
 // @Accept  json
 // @Produce  json
 // @Param id path string true "ID"
+// @Param If-Match header string false "ETag of the version the change is based on"
 // @Param Book request body models.UpdateBook true "BookUpdateRequest"
 // @Success 200
 // @Failure 400 {object} models.StandardErrorModel
+// @Failure 401 {object} models.StandardErrorModel
+// @Failure 403 {object} models.StandardErrorModel
+// @Failure 404 {object} models.StandardErrorModel
+// @Failure 422 {object} models.StandardErrorModel
+// @Failure 412 {object} models.StandardErrorModel
+// @Failure 428 {object} models.StandardErrorModel
 // @Failure 500 {object} models.StandardErrorModel
 // @Router /v1/books/{id} [put]
 func (h *handlerV1) UpdateBook(c *gin.Context) {
-	var (
-		body        pb.Book
-		jspbMarshal protojson.MarshalOptions
-	)
-	jspbMarshal.UseProtoNames = true
+	var body models.UpdateBook
+	if !h.bindJSON(c, &pb.Book{}, &body) {
+		return
+	}
+
+	ctx, cancel := h.requestContext(c)
+	defer cancel()
+
+	expected, ok := h.checkIfMatch(ctx, c, "book", c.Param("id"))
+	if !ok {
+		return
+	}
 
-	err := c.ShouldBindJSON(&body)
+	refs := append([]reference{authorRef("author_id", body.AuthorId)}, categoryRefs("category_id", body.CategoryIds)...)
+	if !h.checkReferences(ctx, c, refs...) {
+		return
+	}
+
+	response, err := h.serviceManager.CatalogService().UpdateBook(ctx, &pb.Book{
+		Id:         c.Param("id"),
+		Name:       body.Name,
+		AuthorId:   body.AuthorId,
+		CategoryId: body.CategoryIds,
+		UpdatedAt:  expected,
+	})
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{
-			"error": err.Error(),
-		})
-		h.log.Error("failed to bind json", l.Error(err))
+		h.handleGRPCError(c, err, "failed to update Book")
+		return
+	}
+
+	setETag(c, response)
+	codec.Render(c, http.StatusOK, response)
+}
+
+// PatchBook ...
+// @Summary PatchBook
+// @Description This API for partially updating book, only the fields in the RFC 7396 merge patch change
+// @Tags book
+// @Security BearerAuth
+// @Accept  application/merge-patch+json
+// @Produce  json
+// @Param id path string true "ID"
+// @Param If-Match header string false "ETag of the version the change is based on"
+// @Param Book request body models.Book true "bookPatchRequest"
+// @Success 200
+// @Header 200 {string} ETag "Version of the resource"
+// @Failure 400 {object} models.StandardErrorModel
+// @Failure 401 {object} models.StandardErrorModel
+// @Failure 403 {object} models.StandardErrorModel
+// @Failure 404 {object} models.StandardErrorModel
+// @Failure 412 {object} models.StandardErrorModel
+// @Failure 415 {object} models.StandardErrorModel
+// @Failure 422 {object} models.StandardErrorModel
+// @Failure 428 {object} models.StandardErrorModel
+// @Failure 500 {object} models.StandardErrorModel
+// @Router /v1/books/{id} [patch]
+func (h *handlerV1) PatchBook(c *gin.Context) {
+	book := &pb.Book{}
+	var body models.Book
+	paths, ok := h.bindMergePatch(c, book, &body)
+	if !ok {
 		return
 	}
-	body.Id = c.Param("id")
 
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.CtxTimeout))
+	ctx, cancel := h.requestContext(c)
 	defer cancel()
 
-	response, err := h.serviceManager.CatalogService().UpdateBook(ctx, &body)
+	expected, ok := h.checkIfMatch(ctx, c, "book", c.Param("id"))
+	if !ok {
+		return
+	}
+
+	refs := append([]reference{authorRef("author_id", book.AuthorId)}, categoryRefs("category_id", book.CategoryId)...)
+	if !h.checkReferences(ctx, c, refs...) {
+		return
+	}
+
+	book.Id = c.Param("id")
+	book.UpdatedAt = expected
+	response, err := h.serviceManager.CatalogService().PatchBook(ctx, &pb.PatchBookReq{
+		Book:       book,
+		UpdateMask: &types.FieldMask{Paths: paths},
+	})
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error": err.Error(),
-		})
-		h.log.Error("failed to update Book", l.Error(err))
+		h.handleGRPCError(c, err, "failed to patch Book")
 		return
 	}
 
-	c.JSON(http.StatusOK, response)
+	setETag(c, response)
+	codec.Render(c, http.StatusOK, response)
 }
 
 // DeleteBook ...
 // @Summary DeleteBook
 // @Description This API for deleting book
 // @Tags book
+// @Security BearerAuth
 // @Accept  json
 // @Produce  json
 // @Param id path string true "ID"
+// @Param If-Match header string false "ETag of the version the change is based on"
 // @Success 200
 // @Failure 400 {object} models.StandardErrorModel
+// @Failure 401 {object} models.StandardErrorModel
+// @Failure 403 {object} models.StandardErrorModel
+// @Failure 404 {object} models.StandardErrorModel
+// @Failure 412 {object} models.StandardErrorModel
+// @Failure 428 {object} models.StandardErrorModel
 // @Failure 500 {object} models.StandardErrorModel
 // @Router /v1/books/{id} [delete]
 func (h *handlerV1) DeleteBook(c *gin.Context) {
-	var jspbMarshal protojson.MarshalOptions
-	jspbMarshal.UseProtoNames = true
-
 	guid := c.Param("id")
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.CtxTimeout))
+	ctx, cancel := h.requestContext(c)
 	defer cancel()
 
+	expected, ok := h.checkIfMatch(ctx, c, "book", guid)
+	if !ok {
+		return
+	}
+
 	response, err := h.serviceManager.CatalogService().DeletedBookById(
 		ctx, &pb.GetBookByIdReq{
-			Id: guid,
+			Id:                guid,
+			ExpectedUpdatedAt: expected,
 		})
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error": err.Error(),
-		})
-		h.log.Error("failed to delete BOok", l.Error(err))
+		h.handleGRPCError(c, err, "failed to delete Book")
 		return
 	}
 
-	c.JSON(http.StatusOK, response)
+	codec.Render(c, http.StatusOK, response)
 }
 
 // ListBooks ...
@@ -173,43 +273,93 @@ func (h *handlerV1) DeleteBook(c *gin.Context) {
 // @Produce  json
 // @Param page query string false "Page"
 // @Param limit query string false "Limit"
+// @Param cursor query string false "Cursor from next_cursor of the previous page, replaces page"
 // @Param author query string false "Author"
-// @Param category query string false "Category"
+// @Param category query []string false "Category IDs, repeated or comma separated" collectionFormat(multi)
+// @Param category_match query string false "Whether books must be in any or all of the categories" Enums(any, all)
+// @Param search query string false "Matches book and author names"
+// @Param ordering query string false "Comma separated sort fields, '-' prefix for descending: name, created_at, updated_at"
+// @Param expand query string false "Comma separated related entities to embed: author, categories"
+// @Param deleted query string false "Whether soft deleted items are listed, include and only need write access to the catalog" Enums(exclude, include, only)
 // @Success 200 {object} models.ListBooks
+// @Header 200 {string} Link "RFC 8288 links to the first, prev, next and last pages"
+// @Header 200 {integer} X-Total-Count "Total number of items"
 // @Failure 400 {object} models.StandardErrorModel
+// @Failure 401 {object} models.StandardErrorModel
+// @Failure 403 {object} models.StandardErrorModel
 // @Failure 500 {object} models.StandardErrorModel
 // @Router /v1/books [get]
 func (h *handlerV1) ListBooks(c *gin.Context) {
-	queryParams := c.Request.URL.Query()
+	filters := filterParser{query: c.Request.URL.Query()}
+	req := pb.ListBookReq{
+		CategoryIds:        filters.ids("category"),
+		MatchAllCategories: filters.oneOf("category_match", categoryMatches) == categoryMatchAll,
+	}
 
-	params, errStr := utils.ParseQueryParams(queryParams)
+	h.listBooks(c, "books", &req, filters.errStr, nil)
+}
+
+// listBooks completes req with the common list params and answers with the
+// page of books. errStr carries the problems the caller found in its own
+// params, resolve runs before the call to fill in filters that need
+// lookups. Cursors are bound to resource.
+func (h *handlerV1) listBooks(c *gin.Context, resource string, req *pb.ListBookReq, errStr []string, resolve func(ctx context.Context, req *pb.ListBookReq) error) {
+	params, paramErrs := utils.ParseQueryParams(c.Request.URL.Query())
+	errStr = append(errStr, paramErrs...)
+	filters := filterParser{query: c.Request.URL.Query()}
+	expand := filters.expand(bookExpansions)
+	deleted := filters.oneOf("deleted", deletedScopes)
+	errStr = append(errStr, filters.errStr...)
+	cursor, pagingErrs := h.parsePaging(c, resource, params)
+	errStr = append(errStr, pagingErrs...)
+	ordering, orderingErrs := parseOrdering(params.Ordering, bookOrderingFields)
+	errStr = append(errStr, orderingErrs...)
 	if errStr != nil {
-		c.JSON(http.StatusBadRequest, gin.H{
-			"error": errStr[0],
-		})
-		h.log.Error("failed to parse query params json" + errStr[0])
+		h.handleInvalidQueryParams(c, errStr)
+		return
+	}
+	if !h.allowDeleted(c, deleted, auth.PermCatalogWrite) {
 		return
 	}
 
-	var jspbMarshal protojson.MarshalOptions
-	jspbMarshal.UseProtoNames = true
+	for _, key := range typedBookParams {
+		delete(params.Filters, key)
+	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.CtxTimeout))
+	ctx, cancel := h.requestContext(c)
 	defer cancel()
 
-	response, err := h.serviceManager.CatalogService().ListBooks(
-		ctx, &pb.ListBookReq{
-			Limit:   params.Limit,
-			Page:    params.Page,
-			Filters: params.Filters,
-		})
+	req.Limit = params.Limit
+	req.Page = params.Page
+	req.Cursor = cursor
+	req.Filters = params.Filters
+	req.Search = strings.TrimSpace(params.Search)
+	req.Ordering = ordering
+	req.Deleted = deleted
+
+	if resolve != nil {
+		if err := resolve(ctx, req); err != nil {
+			h.handleGRPCError(c, err, "failed to resolve Books filters")
+			return
+		}
+	}
+
+	response, err := h.serviceManager.CatalogService().ListBooks(ctx, req)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error": err.Error(),
-		})
-		h.log.Error("failed to list Books", l.Error(err))
+		h.handleGRPCError(c, err, "failed to list Books")
 		return
 	}
 
-	c.JSON(http.StatusOK, response)
+	var extra map[string]interface{}
+	if expand != nil {
+		books, err := h.expandedBookItems(ctx, expand, response.Books)
+		if err != nil {
+			h.handleGRPCError(c, err, "failed to expand Books")
+			return
+		}
+		extra = map[string]interface{}{"books": books}
+	}
+
+	response.NextCursor = h.nextCursor(c, resource, response.NextCursor)
+	h.renderList(c, params, response, extra)
 }
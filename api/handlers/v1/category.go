@@ -3,13 +3,14 @@ package v1
 import (
 	"context"
 	"net/http"
-	"time"
 
 	"github.com/gin-gonic/gin"
-	"google.golang.org/protobuf/encoding/protojson"
+	"github.com/gogo/protobuf/types"
 
+	"github.com/muhriddinsalohiddin/online_store_api/api/codec"
+	"github.com/muhriddinsalohiddin/online_store_api/api/handlers/models"
 	pb "github.com/muhriddinsalohiddin/online_store_api/genproto/catalog_service"
-	l "github.com/muhriddinsalohiddin/online_store_api/pkg/logger"
+	"github.com/muhriddinsalohiddin/online_store_api/pkg/auth"
 	"github.com/muhriddinsalohiddin/online_store_api/pkg/utils"
 )
 
@@ -17,82 +18,94 @@ import (
 // @Summary CreateCategory
 // @Description This API for creating a new category
 // @Tags category
+// @Security BearerAuth
 // @Accept  json
 // @Produce  json
 // @Param category request body models.Category true "categoryCreateRequest"
+// @Param Idempotency-Key header string false "Key making retries of the request safe"
 // @Success 200 {object} models.Category
 // @Failure 400 {object} models.StandardErrorModel
+// @Failure 401 {object} models.StandardErrorModel
+// @Failure 403 {object} models.StandardErrorModel
+// @Failure 409 {object} models.StandardErrorModel
+// @Failure 413 {object} models.StandardErrorModel
+// @Failure 422 {object} models.StandardErrorModel
 // @Failure 500 {object} models.StandardErrorModel
 // @Router /v1/categories/ [post]
 func (h *handlerV1) CreateCategory(c *gin.Context) {
-	var (
-		body        pb.Category
-		jspbMarshal protojson.MarshalOptions
-	)
-	jspbMarshal.UseProtoNames = true
-
-	err := c.ShouldBindJSON(&body)
-	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{
-			"error": err.Error(),
-		})
-		h.log.Error("failed to bind json", l.Error(err))
+	var body models.Category
+	if !h.bindJSON(c, &pb.Category{}, &body) {
 		return
 	}
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.CtxTimeout))
+	ctx, cancel := h.requestContext(c)
 	defer cancel()
 
-	resp, err := h.serviceManager.CatalogService().CreateCategory(ctx, &body)
+	if !h.checkReferences(ctx, c, categoryRef("parent_id", body.ParentId)) {
+		return
+	}
+
+	resp, err := h.serviceManager.CatalogService().CreateCategory(ctx, &pb.Category{
+		Name:     body.Name,
+		ParentId: body.ParentId,
+	})
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error": err.Error(),
-		})
-		h.log.Error("failed to create category", l.Error(err))
+		h.handleGRPCError(c, err, "failed to create category")
 		return
 	}
-	c.JSON(http.StatusCreated, resp)
+	codec.Render(c, http.StatusCreated, resp)
 }
 
 // UpdateCategory ...
 // @Summary UpdateCategory
 // @Description This API for updating category
 // @Tags category
+// @Security BearerAuth
 // @Accept  json
 // @Produce  json
 // @Param id path string true "ID"
+// @Param If-Match header string false "ETag of the version the change is based on"
 // @Param Category request body models.Category true "categoryUpdateRequest"
 // @Success 200
 // @Failure 400 {object} models.StandardErrorModel
+// @Failure 401 {object} models.StandardErrorModel
+// @Failure 403 {object} models.StandardErrorModel
+// @Failure 404 {object} models.StandardErrorModel
+// @Failure 422 {object} models.StandardErrorModel
+// @Failure 412 {object} models.StandardErrorModel
+// @Failure 428 {object} models.StandardErrorModel
 // @Failure 500 {object} models.StandardErrorModel
 // @Router /v1/categories/{id} [put]
 func (h *handlerV1) UpdateCategory(c *gin.Context) {
-	var (
-		body        pb.Category
-		jspbMarshal protojson.MarshalOptions
-	)
-	jspbMarshal.UseProtoNames = true
-
-	err := c.ShouldBindJSON(&body)
-	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{
-			"error": err.Error(),
-		})
-		h.log.Error("failed to bind json", l.Error(err))
+	var body models.Category
+	if !h.bindJSON(c, &pb.Category{}, &body) {
 		return
 	}
-	body.Id = c.Param("id")
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.CtxTimeout))
+	ctx, cancel := h.requestContext(c)
 	defer cancel()
 
-	resp, err := h.serviceManager.CatalogService().UpdateCategory(ctx, &body)
+	expected, ok := h.checkIfMatch(ctx, c, "category", c.Param("id"))
+	if !ok {
+		return
+	}
+	if !h.checkReferences(ctx, c, categoryRef("parent_id", body.ParentId)) {
+		return
+	}
+	if !h.checkCategoryCycle(ctx, c, c.Param("id"), body.ParentId) {
+		return
+	}
+
+	resp, err := h.serviceManager.CatalogService().UpdateCategory(ctx, &pb.Category{
+		Id:        c.Param("id"),
+		Name:      body.Name,
+		ParentId:  body.ParentId,
+		UpdatedAt: expected,
+	})
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error": err.Error(),
-		})
-		h.log.Error("failed to update category", l.Error(err))
+		h.handleGRPCError(c, err, "failed to update category")
 		return
 	}
-	c.JSON(http.StatusOK, resp)
+	setETag(c, resp)
+	codec.Render(c, http.StatusOK, resp)
 }
 
 // GetCategoryById ...
@@ -102,57 +115,124 @@ func (h *handlerV1) UpdateCategory(c *gin.Context) {
 // @Accept  json
 // @Produce  json
 // @Param id path string true "ID"
+// @Param If-None-Match header string false "ETag of the copy the client holds"
 // @Success 200 {object} models.Category
+// @Header 200 {string} ETag "Version of the resource"
+// @Success 304 "Not Modified"
 // @Failure 400 {object} models.StandardErrorModel
+// @Failure 404 {object} models.StandardErrorModel
 // @Failure 500 {object} models.StandardErrorModel
 // @Router /v1/categories/{id} [get]
 func (h *handlerV1) GetCategoryById(c *gin.Context) {
-	var jspbMarshal protojson.MarshalOptions
-	jspbMarshal.UseProtoNames = true
-
 	id := c.Param("id")
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.CtxTimeout))
+	ctx, cancel := h.requestContext(c)
 	defer cancel()
 
 	resp, err := h.serviceManager.CatalogService().GetCategoryById(ctx, &pb.GetCategoryByIdReq{Id: id})
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error": err.Error(),
-		})
-		h.log.Error("failed to get category", l.Error(err))
+		h.handleGRPCError(c, err, "failed to get category")
+		return
+	}
+
+	setETag(c, resp)
+	if notModified(c, resp) {
 		return
 	}
-	c.JSON(http.StatusCreated, resp)
+
+	codec.Render(c, http.StatusOK, resp)
+}
+
+// PatchCategory ...
+// @Summary PatchCategory
+// @Description This API for partially updating category, only the fields in the RFC 7396 merge patch change
+// @Tags category
+// @Security BearerAuth
+// @Accept  application/merge-patch+json
+// @Produce  json
+// @Param id path string true "ID"
+// @Param If-Match header string false "ETag of the version the change is based on"
+// @Param Category request body models.Category true "categoryPatchRequest"
+// @Success 200
+// @Header 200 {string} ETag "Version of the resource"
+// @Failure 400 {object} models.StandardErrorModel
+// @Failure 401 {object} models.StandardErrorModel
+// @Failure 403 {object} models.StandardErrorModel
+// @Failure 404 {object} models.StandardErrorModel
+// @Failure 412 {object} models.StandardErrorModel
+// @Failure 415 {object} models.StandardErrorModel
+// @Failure 422 {object} models.StandardErrorModel
+// @Failure 428 {object} models.StandardErrorModel
+// @Failure 500 {object} models.StandardErrorModel
+// @Router /v1/categories/{id} [patch]
+func (h *handlerV1) PatchCategory(c *gin.Context) {
+	category := &pb.Category{}
+	var body models.Category
+	paths, ok := h.bindMergePatch(c, category, &body)
+	if !ok {
+		return
+	}
+	ctx, cancel := h.requestContext(c)
+	defer cancel()
+
+	expected, ok := h.checkIfMatch(ctx, c, "category", c.Param("id"))
+	if !ok {
+		return
+	}
+	if !h.checkReferences(ctx, c, categoryRef("parent_id", category.ParentId)) {
+		return
+	}
+	if !h.checkCategoryCycle(ctx, c, c.Param("id"), category.ParentId) {
+		return
+	}
+
+	category.Id = c.Param("id")
+	category.UpdatedAt = expected
+	resp, err := h.serviceManager.CatalogService().PatchCategory(ctx, &pb.PatchCategoryReq{
+		Category:   category,
+		UpdateMask: &types.FieldMask{Paths: paths},
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "failed to patch category")
+		return
+	}
+	setETag(c, resp)
+	codec.Render(c, http.StatusOK, resp)
 }
 
 // DeleteCategoryById ...
 // @Summary DeleteCategoryById
 // @Description This API for deleting category
 // @Tags category
+// @Security BearerAuth
 // @Accept  json
 // @Produce  json
 // @Param id path string true "ID"
+// @Param If-Match header string false "ETag of the version the change is based on"
 // @Success 200
 // @Failure 400 {object} models.StandardErrorModel
+// @Failure 401 {object} models.StandardErrorModel
+// @Failure 403 {object} models.StandardErrorModel
+// @Failure 404 {object} models.StandardErrorModel
+// @Failure 412 {object} models.StandardErrorModel
+// @Failure 428 {object} models.StandardErrorModel
 // @Failure 500 {object} models.StandardErrorModel
 // @Router /v1/categories/{id} [delete]
 func (h *handlerV1) DeleteCategoryById(c *gin.Context) {
-	var jspbMarshal protojson.MarshalOptions
-	jspbMarshal.UseProtoNames = true
-
 	id := c.Param("id")
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.CtxTimeout))
+	ctx, cancel := h.requestContext(c)
 	defer cancel()
 
-	resp, err := h.serviceManager.CatalogService().DeleteCategoryById(ctx, &pb.GetCategoryByIdReq{Id: id})
+	expected, ok := h.checkIfMatch(ctx, c, "category", id)
+	if !ok {
+		return
+	}
+
+	resp, err := h.serviceManager.CatalogService().DeleteCategoryById(ctx, &pb.GetCategoryByIdReq{Id: id, ExpectedUpdatedAt: expected})
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error": err.Error(),
-		})
-		h.log.Error("failed to delete category", l.Error(err))
+		h.handleGRPCError(c, err, "failed to delete category")
 		return
 	}
-	c.JSON(http.StatusCreated, resp)
+	codec.Render(c, http.StatusOK, resp)
 }
 
 // ListCategories ...
@@ -163,37 +243,94 @@ func (h *handlerV1) DeleteCategoryById(c *gin.Context) {
 // @Produce  json
 // @Param page query string false "Page"
 // @Param limit query string false "Limit"
+// @Param cursor query string false "Cursor from next_cursor of the previous page, replaces page"
+// @Param parent_id query string false "Parent category ID, lists its children"
+// @Param name_prefix query string false "Name prefix"
+// @Param deleted query string false "Whether soft deleted items are listed, include and only need write access to the catalog" Enums(exclude, include, only)
 // @Success 200 {object} models.ListCategories
+// @Header 200 {string} Link "RFC 8288 links to the first, prev, next and last pages"
+// @Header 200 {integer} X-Total-Count "Total number of items"
 // @Failure 400 {object} models.StandardErrorModel
+// @Failure 401 {object} models.StandardErrorModel
+// @Failure 403 {object} models.StandardErrorModel
 // @Failure 500 {object} models.StandardErrorModel
 // @Router /v1/categories [get]
 func (h *handlerV1) ListCategories(c *gin.Context) {
 	queryParams := c.Request.URL.Query()
 
 	params, errStr := utils.ParseQueryParams(queryParams)
+	cursor, pagingErrs := h.parsePaging(c, "categories", params)
+	errStr = append(errStr, pagingErrs...)
+	filters := filterParser{query: queryParams}
+	parentID := filters.id("parent_id")
+	namePrefix := filters.prefix("name_prefix")
+	deleted := filters.oneOf("deleted", deletedScopes)
+	errStr = append(errStr, filters.errStr...)
 	if errStr != nil {
-		c.JSON(http.StatusBadRequest, gin.H{
-			"error": errStr[0],
-		})
-		h.log.Error("failed to parse qurey params json" + errStr[0])
+		h.handleInvalidQueryParams(c, errStr)
+		return
 	}
-	var jspbMarshal protojson.MarshalOptions
-	jspbMarshal.UseProtoNames = true
-
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.CtxTimeout))
+	if !h.allowDeleted(c, deleted, auth.PermCatalogWrite) {
+		return
+	}
+	ctx, cancel := h.requestContext(c)
 	defer cancel()
 
 	resp, err := h.serviceManager.CatalogService().ListCategories(ctx,
 		&pb.ListCategoryReq{
-			Limit: params.Limit,
-			Page:  params.Page,
+			Limit:      params.Limit,
+			Page:       params.Page,
+			Cursor:     cursor,
+			ParentId:   parentID,
+			NamePrefix: namePrefix,
+			Deleted:    deleted,
 		})
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error": err.Error(),
-		})
-		h.log.Error("failed to list category", l.Error(err))
+		h.handleGRPCError(c, err, "failed to list category")
 		return
 	}
-	c.JSON(http.StatusOK, resp)
+	resp.NextCursor = h.nextCursor(c, "categories", resp.NextCursor)
+	h.renderList(c, params, resp, nil)
+}
+
+// ListCategoryBooks ...
+// @Summary ListCategoryBooks
+// @Description This API for getting list of books in a category
+// @Tags category
+// @Accept  json
+// @Produce  json
+// @Param id path string true "ID"
+// @Param include_descendants query boolean false "Include books of every subcategory"
+// @Param page query string false "Page"
+// @Param limit query string false "Limit"
+// @Param cursor query string false "Cursor from next_cursor of the previous page, replaces page"
+// @Param search query string false "Matches book and author names"
+// @Param ordering query string false "Comma separated sort fields, '-' prefix for descending: name, created_at, updated_at"
+// @Param expand query string false "Comma separated related entities to embed: author, categories"
+// @Param deleted query string false "Whether soft deleted items are listed, include and only need write access to the catalog" Enums(exclude, include, only)
+// @Success 200 {object} models.ListBooks
+// @Header 200 {string} Link "RFC 8288 links to the first, prev, next and last pages"
+// @Header 200 {integer} X-Total-Count "Total number of items"
+// @Failure 400 {object} models.StandardErrorModel
+// @Failure 401 {object} models.StandardErrorModel
+// @Failure 403 {object} models.StandardErrorModel
+// @Failure 404 {object} models.StandardErrorModel
+// @Failure 500 {object} models.StandardErrorModel
+// @Router /v1/categories/{id}/books [get]
+func (h *handlerV1) ListCategoryBooks(c *gin.Context) {
+	id := c.Param("id")
+	filters := filterParser{query: c.Request.URL.Query()}
+	filters.absent("the category comes from the path", "category", "category_match")
+	req := &pb.ListBookReq{
+		CategoryIds: []string{id},
+		// the catalog service walks the subtree, however large it is
+		IncludeDescendants: filters.flag("include_descendants"),
+	}
+
+	h.listBooks(c, "categories/"+id+"/books", req, filters.errStr,
+		func(ctx context.Context, _ *pb.ListBookReq) error {
+			// an unknown category would otherwise just have no books
+			_, err := h.serviceManager.CatalogService().GetCategoryById(ctx, &pb.GetCategoryByIdReq{Id: id})
+			return err
+		})
 }
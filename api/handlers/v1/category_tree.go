@@ -0,0 +1,394 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/muhriddinsalohiddin/online_store_api/api/codec"
+	"github.com/muhriddinsalohiddin/online_store_api/api/handlers/models"
+	"github.com/muhriddinsalohiddin/online_store_api/api/response"
+	pb "github.com/muhriddinsalohiddin/online_store_api/genproto/catalog_service"
+	l "github.com/muhriddinsalohiddin/online_store_api/pkg/logger"
+	"github.com/muhriddinsalohiddin/online_store_api/pkg/utils"
+)
+
+const (
+	// maxCategoryDepth bounds the walk up the parent chain
+	maxCategoryDepth = 100
+	// maxTreeCategories bounds how many categories are loaded at once, for
+	// the tree or the children of one category
+	maxTreeCategories = 10000
+)
+
+var (
+	// errCategorySelfCycle is returned when the parent chain leads back to
+	// the category it starts from
+	errCategorySelfCycle = errors.New("category is its own ancestor")
+	// errCategoryCycle is returned when the stored parent chain loops above
+	// the category, the hierarchy is corrupt
+	errCategoryCycle = errors.New("category hierarchy contains a cycle")
+	// errCategoryTooDeep is returned when the parent chain is longer than
+	// maxCategoryDepth
+	errCategoryTooDeep = errors.New("category hierarchy is too deep")
+	// errCategoryTooLarge is returned when a category has more children
+	// than maxTreeCategories
+	errCategoryTooLarge = errors.New("category has too many subcategories")
+)
+
+// brokenHierarchy reports whether err comes from walking a parent chain that
+// can't be followed to a root
+func brokenHierarchy(err error) bool {
+	return errors.Is(err, errCategorySelfCycle) || errors.Is(err, errCategoryCycle) || errors.Is(err, errCategoryTooDeep)
+}
+
+// GetCategoryTree ...
+// @Summary GetCategoryTree
+// @Description This API for getting the nested category hierarchy
+// @Tags category
+// @Accept  json
+// @Produce  json
+// @Success 200 {object} models.CategoryTree
+// @Failure 500 {object} models.StandardErrorModel
+// @Router /v1/categories/tree [get]
+func (h *handlerV1) GetCategoryTree(c *gin.Context) {
+	ctx, cancel := h.requestContext(c)
+	defer cancel()
+
+	categories, truncated, err := h.allCategories(ctx, "")
+	if err != nil {
+		h.handleGRPCError(c, err, "failed to list categories")
+		return
+	}
+	if truncated {
+		h.requestLog(c).Warn("category tree truncated", l.Int("limit", maxTreeCategories))
+	}
+
+	nodes := make(map[string]*pb.CategoryNode, len(categories))
+	for _, category := range categories {
+		nodes[category.Id] = &pb.CategoryNode{
+			Id:       category.Id,
+			Name:     category.Name,
+			ParentId: category.ParentId,
+			Children: []*pb.CategoryNode{},
+		}
+	}
+
+	tree := &pb.CategoryTree{Categories: []*pb.CategoryNode{}, Truncated: truncated}
+	for _, category := range categories {
+		node := nodes[category.Id]
+		// categories whose parent is gone are shown as roots
+		if parent, ok := nodes[category.ParentId]; ok && category.ParentId != category.Id {
+			parent.Children = append(parent.Children, node)
+			continue
+		}
+		tree.Categories = append(tree.Categories, node)
+	}
+
+	var cut int
+	tree.Categories, cut = breakCategoryCycles(categories, nodes, tree.Categories)
+	if cut > 0 {
+		h.requestLog(c).Warn("category cycles shown as roots", l.Error(errCategoryCycle), l.Int("count", cut))
+	}
+	sortCategoryNodes(tree.Categories)
+
+	codec.Render(c, http.StatusOK, tree)
+}
+
+// ListCategoryChildren ...
+// @Summary ListCategoryChildren
+// @Description This API for getting the direct subcategories of a category
+// @Tags category
+// @Accept  json
+// @Produce  json
+// @Param id path string true "ID"
+// @Param page query string false "Page"
+// @Param limit query string false "Limit"
+// @Param cursor query string false "Cursor from next_cursor of the previous page, replaces page"
+// @Success 200 {object} models.ListCategories
+// @Header 200 {string} Link "RFC 8288 links to the first, prev, next and last pages"
+// @Header 200 {integer} X-Total-Count "Total number of items"
+// @Failure 400 {object} models.StandardErrorModel
+// @Failure 404 {object} models.StandardErrorModel
+// @Failure 500 {object} models.StandardErrorModel
+// @Router /v1/categories/{id}/children [get]
+func (h *handlerV1) ListCategoryChildren(c *gin.Context) {
+	id := c.Param("id")
+	resource := "categories/" + id + "/children"
+
+	params, errStr := utils.ParseQueryParams(c.Request.URL.Query())
+	cursor, pagingErrs := h.parsePaging(c, resource, params)
+	errStr = append(errStr, pagingErrs...)
+	if errStr != nil {
+		h.handleInvalidQueryParams(c, errStr)
+		return
+	}
+	ctx, cancel := h.requestContext(c)
+	defer cancel()
+
+	// an unknown category would otherwise just have no children
+	if _, err := h.serviceManager.CatalogService().GetCategoryById(ctx, &pb.GetCategoryByIdReq{Id: id}); err != nil {
+		h.handleGRPCError(c, err, "failed to get category")
+		return
+	}
+
+	resp, err := h.serviceManager.CatalogService().ListCategories(ctx,
+		&pb.ListCategoryReq{
+			Limit:    params.Limit,
+			Page:     params.Page,
+			Cursor:   cursor,
+			ParentId: id,
+		})
+	if err != nil {
+		h.handleGRPCError(c, err, "failed to list category children")
+		return
+	}
+	resp.NextCursor = h.nextCursor(c, resource, resp.NextCursor)
+	h.renderList(c, params, resp, nil)
+}
+
+// ListCategoryAncestors ...
+// @Summary ListCategoryAncestors
+// @Description This API for getting the breadcrumb of a category, from the root down to its parent
+// @Tags category
+// @Accept  json
+// @Produce  json
+// @Param id path string true "ID"
+// @Success 200 {object} models.ListCategories
+// @Failure 400 {object} models.StandardErrorModel
+// @Failure 404 {object} models.StandardErrorModel
+// @Failure 500 {object} models.StandardErrorModel
+// @Router /v1/categories/{id}/ancestors [get]
+func (h *handlerV1) ListCategoryAncestors(c *gin.Context) {
+	ctx, cancel := h.requestContext(c)
+	defer cancel()
+
+	category, err := h.serviceManager.CatalogService().GetCategoryById(ctx, &pb.GetCategoryByIdReq{Id: c.Param("id")})
+	if err != nil {
+		h.handleGRPCError(c, err, "failed to get category")
+		return
+	}
+
+	ancestors, err := h.categoryAncestors(ctx, category.Id, category.ParentId)
+	if brokenHierarchy(err) {
+		response.Error(c, http.StatusInternalServerError, models.ErrCodeInternal, "the category hierarchy above this category is broken")
+		h.requestLog(c).Error("broken category breadcrumb", l.Error(err), l.String("category_id", category.Id))
+		return
+	}
+	if err != nil {
+		h.handleGRPCError(c, err, "failed to get category ancestors")
+		return
+	}
+
+	breadcrumb := make([]*pb.Category, 0, len(ancestors))
+	for i := len(ancestors) - 1; i >= 0; i-- {
+		breadcrumb = append(breadcrumb, ancestors[i])
+	}
+
+	codec.Render(c, http.StatusOK, &pb.ListCategoryResp{
+		Categories: breadcrumb,
+		Count:      int64(len(breadcrumb)),
+	})
+}
+
+// categoryAncestors walks up from parentID, the parent of category id, and
+// returns the chain starting with that parent. When the chain can't be
+// followed to a root it returns what was collected so far together with
+// errCategorySelfCycle if it reaches id again, errCategoryCycle if it loops
+// elsewhere or errCategoryTooDeep past maxCategoryDepth.
+func (h *handlerV1) categoryAncestors(ctx context.Context, id, parentID string) ([]*pb.Category, error) {
+	var (
+		ancestors []*pb.Category
+		seen      = map[string]bool{id: true}
+	)
+
+	for next := parentID; next != ""; {
+		switch {
+		case next == id:
+			return ancestors, errCategorySelfCycle
+		case seen[next]:
+			return ancestors, errCategoryCycle
+		case len(ancestors) >= maxCategoryDepth:
+			return ancestors, errCategoryTooDeep
+		}
+		seen[next] = true
+
+		category, err := h.serviceManager.CatalogService().GetCategoryById(ctx, &pb.GetCategoryByIdReq{Id: next})
+		if err != nil {
+			return nil, err
+		}
+		ancestors = append(ancestors, category)
+		next = category.ParentId
+	}
+
+	return ancestors, nil
+}
+
+// checkCategoryCycle answers 422 when making parentID the parent of id would
+// turn the category into its own ancestor or nest it or any of its
+// subcategories deeper than maxCategoryDepth, and 500 when the existing
+// hierarchy above parentID already loops. It reports whether the handler may
+// go on.
+func (h *handlerV1) checkCategoryCycle(ctx context.Context, c *gin.Context, id, parentID string) bool {
+	ancestors, err := h.categoryAncestors(ctx, id, parentID)
+	if err == nil && parentID != "" {
+		// the subcategories move along with the category
+		_, err = h.categoryHeight(ctx, id, maxCategoryDepth-len(ancestors))
+	}
+	switch {
+	case errors.Is(err, errCategorySelfCycle):
+		h.handleValidationFailed(c, []models.ErrorDetail{{
+			Field:       "parent_id",
+			Description: "would make the category its own ancestor",
+		}})
+		return false
+	case errors.Is(err, errCategoryTooDeep):
+		h.handleValidationFailed(c, []models.ErrorDetail{{
+			Field:       "parent_id",
+			Description: fmt.Sprintf("would nest the category deeper than %d levels", maxCategoryDepth),
+		}})
+		return false
+	case errors.Is(err, errCategoryTooLarge):
+		response.Error(c, http.StatusInternalServerError, models.ErrCodeInternal, "the category has too many subcategories to check the move")
+		h.requestLog(c).Error("category subtree too large", l.Error(err), l.String("category_id", id))
+		return false
+	case errors.Is(err, errCategoryCycle):
+		response.Error(c, http.StatusInternalServerError, models.ErrCodeInternal, "the existing category hierarchy contains a cycle")
+		h.requestLog(c).Error("corrupt category hierarchy", l.Error(err), l.String("parent_id", parentID))
+		return false
+	case err != nil:
+		h.handleGRPCError(c, err, "failed to get category ancestors")
+		return false
+	}
+
+	return true
+}
+
+// categoryHeight returns how many levels of subcategories hang below
+// category id. It stops with errCategoryTooDeep once there are more than
+// limit levels and with errCategoryTooLarge when a category has more
+// children than can be loaded.
+func (h *handlerV1) categoryHeight(ctx context.Context, id string, limit int) (int, error) {
+	var (
+		level  = []string{id}
+		seen   = map[string]bool{id: true}
+		height int
+	)
+
+	for {
+		var next []string
+		for _, parentID := range level {
+			children, truncated, err := h.allCategories(ctx, parentID)
+			if err != nil {
+				return 0, err
+			}
+			if truncated {
+				return 0, errCategoryTooLarge
+			}
+			for _, child := range children {
+				if !seen[child.Id] {
+					seen[child.Id] = true
+					next = append(next, child.Id)
+				}
+			}
+		}
+		if len(next) == 0 {
+			return height, nil
+		}
+
+		height++
+		if height > limit {
+			return height, errCategoryTooDeep
+		}
+		level = next
+	}
+}
+
+// allCategories pages through ListCategories until every category, or every
+// child of parentID when it is set, is loaded. It stops at maxTreeCategories
+// and reports whether categories were left out.
+func (h *handlerV1) allCategories(ctx context.Context, parentID string) ([]*pb.Category, bool, error) {
+	limit := int64(h.cfg.MaxPageLimit)
+	if limit <= 0 {
+		limit = 100
+	}
+
+	var categories []*pb.Category
+	for page := int64(1); ; page++ {
+		resp, err := h.serviceManager.CatalogService().ListCategories(ctx, &pb.ListCategoryReq{
+			Page:     page,
+			Limit:    limit,
+			ParentId: parentID,
+		})
+		if err != nil {
+			return nil, false, err
+		}
+
+		categories = append(categories, resp.Categories...)
+		if len(resp.Categories) == 0 || int64(len(categories)) >= resp.Count {
+			return categories, false, nil
+		}
+		if len(categories) >= maxTreeCategories {
+			return categories[:maxTreeCategories], true, nil
+		}
+	}
+}
+
+// breakCategoryCycles makes one category of every parent cycle a root, the
+// cycle and whatever hangs below it can't be reached from the roots
+// otherwise. It returns the roots and how many categories it moved.
+func breakCategoryCycles(categories []*pb.Category, nodes map[string]*pb.CategoryNode, roots []*pb.CategoryNode) ([]*pb.CategoryNode, int) {
+	reached := make(map[string]bool, len(nodes))
+	var mark func(nodes []*pb.CategoryNode)
+	mark = func(nodes []*pb.CategoryNode) {
+		for _, node := range nodes {
+			if !reached[node.Id] {
+				reached[node.Id] = true
+				mark(node.Children)
+			}
+		}
+	}
+	mark(roots)
+
+	cut := 0
+	for _, category := range categories {
+		if reached[category.Id] {
+			continue
+		}
+
+		// every parent above a category that wasn't reached is in the tree
+		// and wasn't reached either, so the walk up ends in the cycle
+		node := nodes[category.Id]
+		for seen := map[string]bool{}; !seen[node.Id]; node = nodes[node.ParentId] {
+			seen[node.Id] = true
+		}
+
+		parent := nodes[node.ParentId]
+		for i, child := range parent.Children {
+			if child == node {
+				parent.Children = append(parent.Children[:i], parent.Children[i+1:]...)
+				break
+			}
+		}
+		roots = append(roots, node)
+		mark([]*pb.CategoryNode{node})
+		cut++
+	}
+
+	return roots, cut
+}
+
+// sortCategoryNodes orders every level by name
+func sortCategoryNodes(nodes []*pb.CategoryNode) {
+	sort.Slice(nodes, func(i, j int) bool {
+		return nodes[i].Name < nodes[j].Name
+	})
+
+	for _, node := range nodes {
+		sortCategoryNodes(node.Children)
+	}
+}
@@ -0,0 +1,52 @@
+package v1
+
+import (
+	"context"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"golang.org/x/text/language"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/muhriddinsalohiddin/online_store_api/api/middleware"
+)
+
+// outgoing gRPC metadata keys
+const (
+	mdRequestID = "x-request-id"
+	mdUserID    = "x-user-id"
+	mdUserRole  = "x-user-role"
+	mdLocale    = "x-locale"
+)
+
+// requestContext returns the context for upstream calls. It is cancelled when
+// the client goes away, bounded by the route timeout and carries the request
+// id, caller identity and locale as outgoing metadata.
+func (h *handlerV1) requestContext(c *gin.Context) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithTimeout(c.Request.Context(), h.routeTimeout(c))
+
+	md := metadata.Pairs(mdRequestID, c.GetString(middleware.RequestIDKey))
+	if claims, ok := middleware.GetClaims(c); ok {
+		md.Set(mdUserID, claims.UserID())
+		md.Set(mdUserRole, claims.Role)
+	}
+	if locale := requestLocale(c); locale != "" {
+		md.Set(mdLocale, locale)
+	}
+
+	return metadata.NewOutgoingContext(ctx, md), cancel
+}
+
+func (h *handlerV1) routeTimeout(c *gin.Context) time.Duration {
+	return h.cfg.RouteTimeout(c.Request.Method + " " + c.FullPath())
+}
+
+// requestLocale picks the preferred language of the Accept-Language header
+func requestLocale(c *gin.Context) string {
+	tags, _, err := language.ParseAcceptLanguage(c.GetHeader("Accept-Language"))
+	if err != nil || len(tags) == 0 {
+		return ""
+	}
+
+	return tags[0].String()
+}
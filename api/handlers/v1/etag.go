@@ -0,0 +1,162 @@
+package v1
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/muhriddinsalohiddin/online_store_api/api/codec"
+	"github.com/muhriddinsalohiddin/online_store_api/api/handlers/models"
+	"github.com/muhriddinsalohiddin/online_store_api/api/response"
+	l "github.com/muhriddinsalohiddin/online_store_api/pkg/logger"
+	"github.com/muhriddinsalohiddin/online_store_api/services"
+)
+
+// versioned is implemented by the generated messages of every resource
+type versioned interface {
+	GetId() string
+	GetUpdatedAt() string
+}
+
+// etag is the strong entity tag of a resource version, it changes with
+// UpdatedAt. It's empty when the backend didn't report UpdatedAt.
+func etag(m versioned) string {
+	if m.GetUpdatedAt() == "" {
+		return ""
+	}
+
+	sum := sha256.Sum256([]byte(m.GetId() + "|" + m.GetUpdatedAt()))
+	return `"` + hex.EncodeToString(sum[:12]) + `"`
+}
+
+// setETag sets the ETag header to the version of m
+func setETag(c *gin.Context, m versioned) {
+	if tag := etag(m); tag != "" {
+		c.Header("ETag", tag)
+	}
+}
+
+// renderExpanded writes m with the fields ?expand adds to it. The embedded
+// entities change without m, so the response gets a weak ETag of the
+// rendered body instead of the version of m, If-Match never matches it.
+func (h *handlerV1) renderExpanded(c *gin.Context, m proto.Message, fields map[string]interface{}) {
+	data, err := codec.Merge(m, fields)
+	if err != nil {
+		response.Error(c, http.StatusInternalServerError, models.ErrCodeInternal, "failed to encode response")
+		h.requestLog(c).Error("failed to encode expanded response", l.Error(err))
+		return
+	}
+
+	sum := sha256.Sum256(data)
+	tag := `W/"` + hex.EncodeToString(sum[:12]) + `"`
+	c.Header("ETag", tag)
+	if matchETag(c.GetHeader("If-None-Match"), tag, false) {
+		c.AbortWithStatus(http.StatusNotModified)
+		return
+	}
+
+	codec.RenderRaw(c, http.StatusOK, data)
+}
+
+// notModified answers 304 when If-None-Match already holds the version of
+// m, it reports whether it did
+func notModified(c *gin.Context, m versioned) bool {
+	if !matchETag(c.GetHeader("If-None-Match"), etag(m), false) {
+		return false
+	}
+	c.AbortWithStatus(http.StatusNotModified)
+
+	return true
+}
+
+// checkIfMatch compares If-Match with the stored version of the resource
+// before a write. It answers 412 when the resource changed since the client
+// read it and 428 when the header is missing but cfg.RequireIfMatch is set,
+// and reports whether the handler may go on. The UpdatedAt it returns goes
+// along with the write, so the backend can reject it when another write
+// lands in between. It's empty when the write doesn't depend on a version.
+func (h *handlerV1) checkIfMatch(ctx context.Context, c *gin.Context, entity, id string) (string, bool) {
+	if c.GetHeader("If-Match") == "" {
+		return h.matchVersion(c, entity, nil)
+	}
+
+	// the cached copy may be older than the stored one
+	current, err := h.lookup(services.BypassCache(ctx), reference{entity: entity, id: id})
+	if status.Code(err) == codes.NotFound {
+		response.Error(c, http.StatusPreconditionFailed, models.ErrCodePreconditionFailed, entity+" no longer exists")
+		return "", false
+	}
+	if err != nil {
+		h.handleGRPCError(c, err, "failed to check If-Match")
+		return "", false
+	}
+
+	return h.matchVersion(c, entity, current)
+}
+
+// matchVersion is checkIfMatch for a handler that already loaded the current
+// version of the resource
+func (h *handlerV1) matchVersion(c *gin.Context, entity string, current versioned) (string, bool) {
+	header := c.GetHeader("If-Match")
+	if header == "" {
+		if h.cfg.RequireIfMatch {
+			response.Error(c, http.StatusPreconditionRequired, models.ErrCodePreconditionRequired, "If-Match header is required")
+			return "", false
+		}
+		return "", true
+	}
+
+	if header == "*" {
+		return "", true
+	}
+	tag := etag(current)
+	if !matchETag(header, tag, true) {
+		if tag != "" {
+			c.Header("ETag", tag)
+		}
+		response.Error(c, http.StatusPreconditionFailed, models.ErrCodePreconditionFailed, entity+" was modified, fetch it again and retry")
+		return "", false
+	}
+
+	return current.GetUpdatedAt(), true
+}
+
+// matchETag reports whether the comma separated list of entity tags in
+// header holds tag. The strong comparison used by If-Match never matches
+// weak tags, the weak one used by If-None-Match ignores the W/ prefix.
+func matchETag(header, tag string, strong bool) bool {
+	if header == "" || tag == "" {
+		return false
+	}
+	if strings.HasPrefix(tag, "W/") {
+		if strong {
+			return false
+		}
+		tag = strings.TrimPrefix(tag, "W/")
+	}
+
+	for _, candidate := range strings.Split(header, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" {
+			return true
+		}
+		if strings.HasPrefix(candidate, "W/") {
+			if strong {
+				continue
+			}
+			candidate = strings.TrimPrefix(candidate, "W/")
+		}
+		if candidate == tag {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,357 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/muhriddinsalohiddin/online_store_api/api/codec"
+	pbCatalog "github.com/muhriddinsalohiddin/online_store_api/genproto/catalog_service"
+	pbOrder "github.com/muhriddinsalohiddin/online_store_api/genproto/order_service"
+)
+
+// related entities ?expand can embed
+const (
+	expandAuthor     = "author"
+	expandCategories = "categories"
+	expandBook       = "book"
+	expandBookAuthor = "book.author"
+)
+
+var (
+	bookExpansions = map[string]bool{
+		expandAuthor:     true,
+		expandCategories: true,
+	}
+	orderExpansions = map[string]bool{
+		expandBook:       true,
+		expandBookAuthor: true,
+	}
+)
+
+// maxExpandConcurrency bounds the lookups a single request runs at once
+const maxExpandConcurrency = 8
+
+// null is embedded for related entities that no longer exist
+var null = json.RawMessage("null")
+
+// relatedLoader fetches the entities embedded by ?expand for one request.
+// Every entity is requested once however many items refer to it, the ids
+// go in list calls of up to batchSize and the calls run concurrently.
+type relatedLoader struct {
+	catalog   pbCatalog.CatalogServiceClient
+	batchSize int
+
+	mu         sync.Mutex
+	authors    map[string]*pbCatalog.Author
+	categories map[string]*pbCatalog.Category
+	books      map[string]*pbCatalog.Book
+}
+
+func (h *handlerV1) newRelatedLoader() *relatedLoader {
+	batchSize := h.cfg.MaxPageLimit
+	if batchSize <= 0 {
+		batchSize = 100
+	}
+
+	return &relatedLoader{
+		catalog:    h.serviceManager.CatalogService(),
+		batchSize:  batchSize,
+		authors:    make(map[string]*pbCatalog.Author),
+		categories: make(map[string]*pbCatalog.Category),
+		books:      make(map[string]*pbCatalog.Book),
+	}
+}
+
+func (l *relatedLoader) loadAuthors(ctx context.Context, ids []string) error {
+	return l.fetch(ctx, ids, func(id string) bool {
+		_, ok := l.authors[id]
+		return ok
+	}, func(ctx context.Context, batch []string) error {
+		resp, err := l.catalog.ListAuthors(ctx, &pbCatalog.ListAuthorReq{Ids: batch, Page: 1, Limit: int64(len(batch))})
+		if err != nil {
+			return err
+		}
+		l.store(func() {
+			for _, id := range batch {
+				l.authors[id] = nil
+			}
+			for _, author := range resp.Authors {
+				l.authors[author.Id] = author
+			}
+		})
+		return nil
+	})
+}
+
+func (l *relatedLoader) loadCategories(ctx context.Context, ids []string) error {
+	return l.fetch(ctx, ids, func(id string) bool {
+		_, ok := l.categories[id]
+		return ok
+	}, func(ctx context.Context, batch []string) error {
+		resp, err := l.catalog.ListCategories(ctx, &pbCatalog.ListCategoryReq{Ids: batch, Page: 1, Limit: int64(len(batch))})
+		if err != nil {
+			return err
+		}
+		l.store(func() {
+			for _, id := range batch {
+				l.categories[id] = nil
+			}
+			for _, category := range resp.Categories {
+				l.categories[category.Id] = category
+			}
+		})
+		return nil
+	})
+}
+
+func (l *relatedLoader) loadBooks(ctx context.Context, ids []string) error {
+	return l.fetch(ctx, ids, func(id string) bool {
+		_, ok := l.books[id]
+		return ok
+	}, func(ctx context.Context, batch []string) error {
+		resp, err := l.catalog.ListBooks(ctx, &pbCatalog.ListBookReq{Ids: batch, Page: 1, Limit: int64(len(batch))})
+		if err != nil {
+			return err
+		}
+		l.store(func() {
+			for _, id := range batch {
+				l.books[id] = nil
+			}
+			for _, book := range resp.Books {
+				l.books[book.Id] = book
+			}
+		})
+		return nil
+	})
+}
+
+func (l *relatedLoader) store(set func()) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	set()
+}
+
+// fetch runs list for the distinct ids that aren't loaded yet, batchSize
+// ids at a time. list stores the entities that don't exist as nil, the
+// first error is returned.
+func (l *relatedLoader) fetch(ctx context.Context, ids []string, loaded func(id string) bool, list func(ctx context.Context, batch []string) error) error {
+	var pending []string
+	seen := make(map[string]bool, len(ids))
+
+	l.mu.Lock()
+	for _, id := range ids {
+		if id == "" || seen[id] || loaded(id) {
+			continue
+		}
+		seen[id] = true
+		pending = append(pending, id)
+	}
+	l.mu.Unlock()
+
+	var (
+		wg       sync.WaitGroup
+		once     sync.Once
+		firstErr error
+		sem      = make(chan struct{}, maxExpandConcurrency)
+	)
+	for len(pending) > 0 {
+		n := l.batchSize
+		if n > len(pending) {
+			n = len(pending)
+		}
+		batch := pending[:n]
+		pending = pending[n:]
+
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			if err := list(ctx, batch); err != nil {
+				once.Do(func() { firstErr = err })
+			}
+		}()
+	}
+	wg.Wait()
+
+	return firstErr
+}
+
+// expandBooks returns the fields ?expand adds to every book
+func (h *handlerV1) expandBooks(ctx context.Context, expand map[string]bool, books []*pbCatalog.Book) ([]map[string]interface{}, error) {
+	if len(expand) == 0 {
+		return nil, nil
+	}
+
+	var authorIDs, categoryIDs []string
+	for _, book := range books {
+		authorIDs = append(authorIDs, book.AuthorId)
+		categoryIDs = append(categoryIDs, book.CategoryId...)
+	}
+
+	loader := h.newRelatedLoader()
+	var wg sync.WaitGroup
+	var authorsErr, categoriesErr error
+	if expand[expandAuthor] {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			authorsErr = loader.loadAuthors(ctx, authorIDs)
+		}()
+	}
+	if expand[expandCategories] {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			categoriesErr = loader.loadCategories(ctx, categoryIDs)
+		}()
+	}
+	wg.Wait()
+	if authorsErr != nil {
+		return nil, authorsErr
+	}
+	if categoriesErr != nil {
+		return nil, categoriesErr
+	}
+
+	fields := make([]map[string]interface{}, len(books))
+	for i, book := range books {
+		f, err := loader.bookFields(expand, book)
+		if err != nil {
+			return nil, err
+		}
+		fields[i] = f
+	}
+
+	return fields, nil
+}
+
+func (l *relatedLoader) bookFields(expand map[string]bool, book *pbCatalog.Book) (map[string]interface{}, error) {
+	fields := make(map[string]interface{}, len(expand))
+
+	if expand[expandAuthor] {
+		fields[expandAuthor] = null
+		if author := l.authors[book.AuthorId]; author != nil {
+			data, err := codec.Marshal(author)
+			if err != nil {
+				return nil, err
+			}
+			fields[expandAuthor] = json.RawMessage(data)
+		}
+	}
+
+	if expand[expandCategories] {
+		categories := make([]json.RawMessage, 0, len(book.CategoryId))
+		for _, id := range book.CategoryId {
+			category := l.categories[id]
+			if category == nil {
+				continue
+			}
+			data, err := codec.Marshal(category)
+			if err != nil {
+				return nil, err
+			}
+			categories = append(categories, json.RawMessage(data))
+		}
+		fields[expandCategories] = categories
+	}
+
+	return fields, nil
+}
+
+// expandOrders returns the fields ?expand adds to every order
+func (h *handlerV1) expandOrders(ctx context.Context, expand map[string]bool, orders []*pbOrder.Order) ([]map[string]interface{}, error) {
+	if len(expand) == 0 {
+		return nil, nil
+	}
+
+	bookIDs := make([]string, 0, len(orders))
+	for _, order := range orders {
+		bookIDs = append(bookIDs, order.BookId)
+	}
+
+	loader := h.newRelatedLoader()
+	if err := loader.loadBooks(ctx, bookIDs); err != nil {
+		return nil, err
+	}
+
+	bookExpand := map[string]bool{}
+	if expand[expandBookAuthor] {
+		bookExpand[expandAuthor] = true
+
+		authorIDs := make([]string, 0, len(loader.books))
+		for _, book := range loader.books {
+			if book != nil {
+				authorIDs = append(authorIDs, book.AuthorId)
+			}
+		}
+		if err := loader.loadAuthors(ctx, authorIDs); err != nil {
+			return nil, err
+		}
+	}
+
+	fields := make([]map[string]interface{}, len(orders))
+	for i, order := range orders {
+		fields[i] = map[string]interface{}{expandBook: null}
+
+		book := loader.books[order.BookId]
+		if book == nil {
+			continue
+		}
+		bf, err := loader.bookFields(bookExpand, book)
+		if err != nil {
+			return nil, err
+		}
+		data, err := codec.Merge(book, bf)
+		if err != nil {
+			return nil, err
+		}
+		fields[i][expandBook] = data
+	}
+
+	return fields, nil
+}
+
+// expandedBookItems encodes every book together with its expanded fields
+func (h *handlerV1) expandedBookItems(ctx context.Context, expand map[string]bool, books []*pbCatalog.Book) ([]json.RawMessage, error) {
+	fields, err := h.expandBooks(ctx, expand, books)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]proto.Message, len(books))
+	for i, book := range books {
+		items[i] = book
+	}
+	return mergeItems(items, fields)
+}
+
+// expandedOrderItems encodes every order together with its expanded fields
+func (h *handlerV1) expandedOrderItems(ctx context.Context, expand map[string]bool, orders []*pbOrder.Order) ([]json.RawMessage, error) {
+	fields, err := h.expandOrders(ctx, expand, orders)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]proto.Message, len(orders))
+	for i, order := range orders {
+		items[i] = order
+	}
+	return mergeItems(items, fields)
+}
+
+func mergeItems(items []proto.Message, fields []map[string]interface{}) ([]json.RawMessage, error) {
+	merged := make([]json.RawMessage, len(items))
+	for i, item := range items {
+		var err error
+		if merged[i], err = codec.Merge(item, fields[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	return merged, nil
+}
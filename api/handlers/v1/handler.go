@@ -1,29 +1,79 @@
 package v1
 
 import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/muhriddinsalohiddin/online_store_api/api/handlers/models"
+	"github.com/muhriddinsalohiddin/online_store_api/api/middleware"
+	"github.com/muhriddinsalohiddin/online_store_api/api/response"
 	"github.com/muhriddinsalohiddin/online_store_api/config"
-	"github.com/muhriddinsalohiddin/online_store_api/pkg/logger"
+	"github.com/muhriddinsalohiddin/online_store_api/pkg/cursor"
+	l "github.com/muhriddinsalohiddin/online_store_api/pkg/logger"
 	"github.com/muhriddinsalohiddin/online_store_api/services"
 )
 
 type handlerV1 struct {
-	log            logger.Logger
+	log            l.Logger
 	serviceManager services.IServiceManager
 	cfg            config.Config
+	cursors        *cursor.Signer
 }
 
 // HandlerV1Config ...
 type HandlerV1Config struct {
-	Logger         logger.Logger
+	Logger         l.Logger
 	ServiceManager services.IServiceManager
 	Cfg            config.Config
 }
 
 // New ...
 func New(c *HandlerV1Config) *handlerV1 {
+	registerValidator()
+
 	return &handlerV1{
 		log:            c.Logger,
 		serviceManager: c.ServiceManager,
 		cfg:            c.Cfg,
+		cursors:        cursor.NewSigner(c.Cfg.CursorSigningKey, time.Duration(c.Cfg.CursorTTL)*time.Second),
 	}
 }
+
+// requestLog returns the request scoped logger, annotated with the request
+// and trace ids
+func (h *handlerV1) requestLog(c *gin.Context) l.Logger {
+	return middleware.GetLogger(c, h.log)
+}
+
+// handleGRPCError writes the translated gRPC error to the client and logs it,
+// client side errors are logged as warnings
+func (h *handlerV1) handleGRPCError(c *gin.Context, err error, msg string) {
+	httpStatus := response.GRPCError(c, err)
+	_ = c.Error(err)
+
+	log := h.requestLog(c)
+	if httpStatus >= http.StatusInternalServerError {
+		log.Error(msg, l.Error(err), l.Int("status", httpStatus))
+		return
+	}
+	log.Warn(msg, l.Error(err), l.Int("status", httpStatus))
+}
+
+// handleBadRequest ...
+func (h *handlerV1) handleBadRequest(c *gin.Context, err error, msg string) {
+	response.Error(c, http.StatusBadRequest, models.ErrCodeInvalidArgument, err.Error())
+	h.requestLog(c).Warn(msg, l.Error(err))
+}
+
+// handleInvalidQueryParams reports every query param that failed to parse
+func (h *handlerV1) handleInvalidQueryParams(c *gin.Context, errStr []string) {
+	details := make([]models.ErrorDetail, 0, len(errStr))
+	for _, e := range errStr {
+		details = append(details, models.ErrorDetail{Description: e})
+	}
+
+	response.Error(c, http.StatusBadRequest, models.ErrCodeInvalidArgument, "invalid query params", details...)
+	h.requestLog(c).Warn("failed to parse query params", l.Any("errors", errStr))
+}
@@ -0,0 +1,65 @@
+package v1
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/muhriddinsalohiddin/online_store_api/api/handlers/models"
+)
+
+// readinessTimeout bounds the backend probes so the endpoint answers within
+// the usual kubernetes probe timeout
+const readinessTimeout = time.Second * 2
+
+// Liveness ...
+// @Summary Liveness
+// @Description This API reports that the process is alive
+// @Tags health
+// @Produce  json
+// @Success 200 {object} models.Health
+// @Router /healthz [get]
+func (h *handlerV1) Liveness(c *gin.Context) {
+	c.JSON(http.StatusOK, models.Health{
+		Status: models.HealthStatusOK,
+	})
+}
+
+// Readiness ...
+// @Summary Readiness
+// @Description This API reports whether the catalog and order services are reachable
+// @Tags health
+// @Produce  json
+// @Success 200 {object} models.Health
+// @Failure 503 {object} models.Health
+// @Router /readyz [get]
+func (h *handlerV1) Readiness(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(c.Request.Context(), readinessTimeout)
+	defer cancel()
+
+	resp := models.Health{
+		Status:       models.HealthStatusOK,
+		Dependencies: make(map[string]models.DependencyHealth),
+	}
+	for name, st := range h.serviceManager.Check(ctx) {
+		dep := models.DependencyHealth{
+			Status:  models.HealthStatusOK,
+			State:   st.State,
+			Serving: st.Serving,
+			Error:   st.Error,
+		}
+		if !st.Healthy {
+			dep.Status = models.HealthStatusUnavailable
+			resp.Status = models.HealthStatusUnavailable
+		}
+		resp.Dependencies[name] = dep
+	}
+
+	if resp.Status != models.HealthStatusOK {
+		c.JSON(http.StatusServiceUnavailable, resp)
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
@@ -3,210 +3,392 @@ package v1
 import (
 	"context"
 	"net/http"
-	"time"
 
 	"github.com/gin-gonic/gin"
-	"google.golang.org/protobuf/encoding/protojson"
+	"github.com/gogo/protobuf/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
-	_ "github.com/muhriddinsalohiddin/online_store_api/api/handlers/models"
+	"github.com/muhriddinsalohiddin/online_store_api/api/codec"
+	"github.com/muhriddinsalohiddin/online_store_api/api/handlers/models"
+	"github.com/muhriddinsalohiddin/online_store_api/api/middleware"
 	pb "github.com/muhriddinsalohiddin/online_store_api/genproto/order_service"
-	l "github.com/muhriddinsalohiddin/online_store_api/pkg/logger"
+	"github.com/muhriddinsalohiddin/online_store_api/pkg/auth"
 	"github.com/muhriddinsalohiddin/online_store_api/pkg/utils"
 )
 
+// orderReplaceFields are the fields UpdateOrder replaces
+var orderReplaceFields = []string{"book_id", "description"}
+
 // CreateOrder ...
 // @Summary CreateOrder
 // @Description This API for creating a new order
 // @Tags Order
+// @Security BearerAuth
 // @Accept  json
 // @Produce  json
 // @Param Order request body models.Order true "orderCreateRequest"
+// @Param Idempotency-Key header string false "Key making retries of the request safe"
 // @Success 200 {object} models.Order
 // @Failure 400 {object} models.StandardErrorModel
+// @Failure 401 {object} models.StandardErrorModel
+// @Failure 403 {object} models.StandardErrorModel
+// @Failure 409 {object} models.StandardErrorModel
+// @Failure 413 {object} models.StandardErrorModel
+// @Failure 422 {object} models.StandardErrorModel
 // @Failure 500 {object} models.StandardErrorModel
 // @Router /v1/orders/ [post]
 func (h *handlerV1) CreateOrder(c *gin.Context) {
-	var (
-		body        pb.Order
-		jspbMarshal protojson.MarshalOptions
-	)
-	jspbMarshal.UseProtoNames = true
-
-	err := c.ShouldBindJSON(&body)
-	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{
-			"error": err.Error(),
-		})
-		h.log.Error("failed to bind json", l.Error(err))
+	var body models.Order
+	if !h.bindJSON(c, &pb.Order{}, &body) {
 		return
 	}
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.CtxTimeout))
+	order := pb.Order{
+		BookId:      body.BookId,
+		Description: body.Description,
+		Status:      models.OrderStatusPending,
+	}
+	if claims, ok := middleware.GetClaims(c); ok {
+		order.UserId = claims.UserID()
+	}
+
+	ctx, cancel := h.requestContext(c)
 	defer cancel()
-	response, err := h.serviceManager.OrderService().CreateOrder(ctx, &body)
+
+	if !h.checkReferences(ctx, c, bookRef("book_id", body.BookId)) {
+		return
+	}
+
+	response, err := h.serviceManager.OrderService().CreateOrder(ctx, &order)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error": err.Error(),
-		})
-		h.log.Error("failed to create order", l.Error(err))
+		h.handleGRPCError(c, err, "failed to create order")
 		return
 	}
-	c.JSON(http.StatusCreated, response)
+	codec.Render(c, http.StatusCreated, response)
 }
 
 // GetOrder ...
 // @Summary GetOrder
 // @Description This API for getting order detail
 // @Tags Order
+// @Security BearerAuth
 // @Accept  json
 // @Produce  json
 // @Param id path string true "ID"
+// @Param If-None-Match header string false "ETag of the copy the client holds"
+// @Param expand query string false "Comma separated related entities to embed: book, book.author"
 // @Success 200 {object} models.Order
+// @Header 200 {string} ETag "Version of the resource, a weak tag of the body when expand is set"
+// @Success 304 "Not Modified"
 // @Failure 400 {object} models.StandardErrorModel
+// @Failure 401 {object} models.StandardErrorModel
+// @Failure 403 {object} models.StandardErrorModel
+// @Failure 404 {object} models.StandardErrorModel
 // @Failure 500 {object} models.StandardErrorModel
 // @Router /v1/orders/{id} [get]
 func (h *handlerV1) GetOrderById(c *gin.Context) {
-	var jspbMarshal protojson.MarshalOptions
-	jspbMarshal.UseProtoNames = true
-
 	guid := c.Param("id")
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.CtxTimeout))
+	filters := filterParser{query: c.Request.URL.Query()}
+	expand := filters.expand(orderExpansions)
+	if filters.errStr != nil {
+		h.handleInvalidQueryParams(c, filters.errStr)
+		return
+	}
+
+	ctx, cancel := h.requestContext(c)
 	defer cancel()
 
-	response, err := h.serviceManager.OrderService().GetOrderById(
-		ctx, &pb.GetOrderByIdReq{
-			Id: guid,
-		})
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error": err.Error(),
-		})
-		h.log.Error("failed to get order", l.Error(err))
+	response, ok := h.loadOrder(ctx, c, guid)
+	if !ok {
 		return
 	}
 
-	c.JSON(http.StatusOK, response)
+	if len(expand) == 0 {
+		setETag(c, response)
+		if !notModified(c, response) {
+			codec.Render(c, http.StatusOK, response)
+		}
+		return
+	}
+
+	fields, err := h.expandOrders(ctx, expand, []*pb.Order{response})
+	if err != nil {
+		h.handleGRPCError(c, err, "failed to expand order")
+		return
+	}
+	h.renderExpanded(c, response, fields[0])
 }
 
 // UpdateOrder ...
 // @Summary UpdateOrder
-// @Description This API for updating order
+// @Description This API for updating order, the status and owner are kept
 // @Tags Order
+// @Security BearerAuth
 // @Accept  json
 // @Produce  json
 // @Param id path string true "ID"
+// @Param If-Match header string false "ETag of the version the change is based on"
 // @Param Order request body models.Order true "OrderUpdateRequest"
 // @Success 200
 // @Failure 400 {object} models.StandardErrorModel
+// @Failure 401 {object} models.StandardErrorModel
+// @Failure 403 {object} models.StandardErrorModel
+// @Failure 404 {object} models.StandardErrorModel
+// @Failure 422 {object} models.StandardErrorModel
+// @Failure 412 {object} models.StandardErrorModel
+// @Failure 428 {object} models.StandardErrorModel
 // @Failure 500 {object} models.StandardErrorModel
 // @Router /v1/orders/{id} [put]
 func (h *handlerV1) UpdateOrder(c *gin.Context) {
-	var (
-		body        pb.Order
-		jspbMarshal protojson.MarshalOptions
-	)
-	jspbMarshal.UseProtoNames = true
+	var body models.Order
+	if !h.bindJSON(c, &pb.Order{}, &body) {
+		return
+	}
+
+	ctx, cancel := h.requestContext(c)
+	defer cancel()
+
+	current, ok := h.loadOrder(ctx, c, c.Param("id"))
+	if !ok {
+		return
+	}
+	expected, ok := h.matchVersion(c, "order", current)
+	if !ok {
+		return
+	}
+	if !h.checkReferences(ctx, c, bookRef("book_id", body.BookId)) {
+		return
+	}
 
-	err := c.ShouldBindJSON(&body)
+	// status and owner only change through their own endpoints, a PUT
+	// replaces the fields the client can set and nothing else
+	response, err := h.serviceManager.OrderService().PatchOrder(ctx, &pb.PatchOrderReq{
+		Order: &pb.Order{
+			Id:          c.Param("id"),
+			BookId:      body.BookId,
+			Description: body.Description,
+			UpdatedAt:   expected,
+		},
+		UpdateMask: &types.FieldMask{Paths: orderReplaceFields},
+	})
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{
-			"error": err.Error(),
-		})
-		h.log.Error("failed to bind json", l.Error(err))
+		h.handleGRPCError(c, err, "failed to update order")
+		return
+	}
+
+	setETag(c, response)
+	codec.Render(c, http.StatusOK, response)
+}
+
+// PatchOrder ...
+// @Summary PatchOrder
+// @Description This API for partially updating order, only the fields in the RFC 7396 merge patch change
+// @Tags Order
+// @Security BearerAuth
+// @Accept  application/merge-patch+json
+// @Produce  json
+// @Param id path string true "ID"
+// @Param If-Match header string false "ETag of the version the change is based on"
+// @Param Order request body models.Order true "orderPatchRequest"
+// @Success 200
+// @Header 200 {string} ETag "Version of the resource"
+// @Failure 400 {object} models.StandardErrorModel
+// @Failure 401 {object} models.StandardErrorModel
+// @Failure 403 {object} models.StandardErrorModel
+// @Failure 404 {object} models.StandardErrorModel
+// @Failure 412 {object} models.StandardErrorModel
+// @Failure 415 {object} models.StandardErrorModel
+// @Failure 422 {object} models.StandardErrorModel
+// @Failure 428 {object} models.StandardErrorModel
+// @Failure 500 {object} models.StandardErrorModel
+// @Router /v1/orders/{id} [patch]
+func (h *handlerV1) PatchOrder(c *gin.Context) {
+	order := &pb.Order{}
+	var body models.Order
+	paths, ok := h.bindMergePatch(c, order, &body)
+	if !ok {
 		return
 	}
-	body.Id = c.Param("id")
 
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.CtxTimeout))
+	ctx, cancel := h.requestContext(c)
 	defer cancel()
 
-	response, err := h.serviceManager.OrderService().UpdateOrder(ctx, &body)
+	current, ok := h.loadOrder(ctx, c, c.Param("id"))
+	if !ok {
+		return
+	}
+	expected, ok := h.matchVersion(c, "order", current)
+	if !ok {
+		return
+	}
+	if !h.checkReferences(ctx, c, bookRef("book_id", order.BookId)) {
+		return
+	}
+
+	order.Id = c.Param("id")
+	order.UpdatedAt = expected
+	response, err := h.serviceManager.OrderService().PatchOrder(ctx, &pb.PatchOrderReq{
+		Order:      order,
+		UpdateMask: &types.FieldMask{Paths: paths},
+	})
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error": err.Error(),
-		})
-		h.log.Error("failed to update order", l.Error(err))
+		h.handleGRPCError(c, err, "failed to patch order")
 		return
 	}
 
-	c.JSON(http.StatusOK, response)
+	setETag(c, response)
+	codec.Render(c, http.StatusOK, response)
 }
 
 // DeleteOrder ...
 // @Summary DeleteOrder
 // @Description This API for deleting Order
 // @Tags Order
+// @Security BearerAuth
 // @Accept  json
 // @Produce  json
 // @Param id path string true "ID"
+// @Param If-Match header string false "ETag of the version the change is based on"
 // @Success 200
 // @Failure 400 {object} models.StandardErrorModel
+// @Failure 401 {object} models.StandardErrorModel
+// @Failure 403 {object} models.StandardErrorModel
+// @Failure 404 {object} models.StandardErrorModel
+// @Failure 412 {object} models.StandardErrorModel
+// @Failure 428 {object} models.StandardErrorModel
 // @Failure 500 {object} models.StandardErrorModel
 // @Router /v1/orders/{id} [delete]
 func (h *handlerV1) DeleteOrder(c *gin.Context) {
-	var jspbMarshal protojson.MarshalOptions
-	jspbMarshal.UseProtoNames = true
-
 	guid := c.Param("id")
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.CtxTimeout))
+	ctx, cancel := h.requestContext(c)
 	defer cancel()
 
+	current, ok := h.loadOrder(ctx, c, guid)
+	if !ok {
+		return
+	}
+	expected, ok := h.matchVersion(c, "order", current)
+	if !ok {
+		return
+	}
+
 	response, err := h.serviceManager.OrderService().DeleteById(
 		ctx, &pb.GetOrderByIdReq{
-			Id: guid,
+			Id:                guid,
+			ExpectedUpdatedAt: expected,
 		})
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error": err.Error(),
-		})
-		h.log.Error("failed to delete Order", l.Error(err))
+		h.handleGRPCError(c, err, "failed to delete Order")
 		return
 	}
 
-	c.JSON(http.StatusOK, response)
+	codec.Render(c, http.StatusOK, response)
 }
 
 // ListOrders ...
 // @Summary ListOrders
-// @Description This API for getting list of Orders
+// @Description This API for getting list of Orders, customers only see their own
 // @Tags Order
+// @Security BearerAuth
 // @Accept  json
 // @Produce  json
 // @Param page query string false "Page"
 // @Param limit query string false "Limit"
+// @Param cursor query string false "Cursor from next_cursor of the previous page, replaces page"
+// @Param book_id query string false "Book ID"
+// @Param created_from query string false "Created at or after, RFC 3339 timestamp or YYYY-MM-DD"
+// @Param created_to query string false "Created at or before, RFC 3339 timestamp or YYYY-MM-DD"
+// @Param status query string false "Status" Enums(pending, paid, shipped, delivered, cancelled, refunded)
+// @Param expand query string false "Comma separated related entities to embed: book, book.author"
+// @Param deleted query string false "Whether soft deleted items are listed, include and only are limited to admins" Enums(exclude, include, only)
 // @Success 200 {object} models.ListOrders
+// @Header 200 {string} Link "RFC 8288 links to the first, prev, next and last pages"
+// @Header 200 {integer} X-Total-Count "Total number of items"
 // @Failure 400 {object} models.StandardErrorModel
+// @Failure 401 {object} models.StandardErrorModel
+// @Failure 403 {object} models.StandardErrorModel
 // @Failure 500 {object} models.StandardErrorModel
 // @Router /v1/orders [get]
 func (h *handlerV1) ListOrders(c *gin.Context) {
 	queryParams := c.Request.URL.Query()
 
 	params, errStr := utils.ParseQueryParams(queryParams)
+	cursor, pagingErrs := h.parsePaging(c, "orders", params)
+	errStr = append(errStr, pagingErrs...)
+	filters := filterParser{query: queryParams}
+	bookID := filters.id("book_id")
+	createdFrom, createdTo := filters.timeRange("created_from", "created_to")
+	status := filters.oneOf("status", orderStatuses)
+	expand := filters.expand(orderExpansions)
+	deleted := filters.oneOf("deleted", deletedScopes)
+	errStr = append(errStr, filters.errStr...)
 	if errStr != nil {
-		c.JSON(http.StatusBadRequest, gin.H{
-			"error": errStr[0],
-		})
-		h.log.Error("failed to parse query params json" + errStr[0])
+		h.handleInvalidQueryParams(c, errStr)
+		return
+	}
+	if !h.allowDeleted(c, deleted, auth.PermOrderManage) {
 		return
 	}
 
-	var jspbMarshal protojson.MarshalOptions
-	jspbMarshal.UseProtoNames = true
-
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.CtxTimeout))
+	ctx, cancel := h.requestContext(c)
 	defer cancel()
 
 	response, err := h.serviceManager.OrderService().ListOrders(
 		ctx, &pb.ListOrderReq{
-			Limit: params.Limit,
-			Page:  params.Page,
+			Limit:       params.Limit,
+			Page:        params.Page,
+			Cursor:      cursor,
+			BookId:      bookID,
+			CreatedFrom: createdFrom,
+			CreatedTo:   createdTo,
+			Status:      status,
+			Deleted:     deleted,
+			UserId:      orderOwner(c),
 		})
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error": err.Error(),
-		})
-		h.log.Error("failed to list Orders", l.Error(err))
+		h.handleGRPCError(c, err, "failed to list Orders")
 		return
 	}
 
-	c.JSON(http.StatusOK, response)
+	var extra map[string]interface{}
+	if expand != nil {
+		orders, err := h.expandedOrderItems(ctx, expand, response.Orders)
+		if err != nil {
+			h.handleGRPCError(c, err, "failed to expand Orders")
+			return
+		}
+		extra = map[string]interface{}{"orders": orders}
+	}
+
+	response.NextCursor = h.nextCursor(c, "orders", response.NextCursor)
+	h.renderList(c, params, response, extra)
+}
+
+// orderOwner returns the user whose orders the caller may access, it's
+// empty for callers who may access the orders of every user
+func orderOwner(c *gin.Context) string {
+	claims, ok := middleware.GetClaims(c)
+	if !ok || claims.HasPermission(auth.PermOrderManage) {
+		return ""
+	}
+
+	return claims.UserID()
+}
+
+// loadOrder gets order id for the caller. The orders of other users answer
+// 404 just like missing ones, so their ids can't be probed. It reports
+// whether the handler may go on.
+func (h *handlerV1) loadOrder(ctx context.Context, c *gin.Context, id string) (*pb.Order, bool) {
+	order, err := h.serviceManager.OrderService().GetOrderById(ctx, &pb.GetOrderByIdReq{Id: id})
+	if err != nil {
+		h.handleGRPCError(c, err, "failed to get order")
+		return nil, false
+	}
+
+	if owner := orderOwner(c); owner != "" && order.UserId != owner {
+		h.handleGRPCError(c, status.Error(codes.NotFound, "order not found"), "order of another user")
+		return nil, false
+	}
+
+	return order, true
 }
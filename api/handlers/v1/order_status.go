@@ -0,0 +1,257 @@
+package v1
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/muhriddinsalohiddin/online_store_api/api/codec"
+	"github.com/muhriddinsalohiddin/online_store_api/api/handlers/models"
+	"github.com/muhriddinsalohiddin/online_store_api/api/middleware"
+	"github.com/muhriddinsalohiddin/online_store_api/api/response"
+	pb "github.com/muhriddinsalohiddin/online_store_api/genproto/order_service"
+	l "github.com/muhriddinsalohiddin/online_store_api/pkg/logger"
+)
+
+// orderTransitions lists the statuses an order may move to from each
+// status, cancelled and refunded are final
+var orderTransitions = map[string][]string{
+	models.OrderStatusPending:   {models.OrderStatusPaid, models.OrderStatusCancelled},
+	models.OrderStatusPaid:      {models.OrderStatusShipped, models.OrderStatusCancelled, models.OrderStatusRefunded},
+	models.OrderStatusShipped:   {models.OrderStatusDelivered},
+	models.OrderStatusDelivered: {models.OrderStatusRefunded},
+	models.OrderStatusCancelled: nil,
+	models.OrderStatusRefunded:  nil,
+}
+
+func canTransition(from, to string) bool {
+	for _, next := range orderTransitions[from] {
+		if next == to {
+			return true
+		}
+	}
+
+	return false
+}
+
+// transitionOrder moves the order to the status to when the transition table
+// allows it from the current status and answers 409 otherwise. If-Match and
+// the table are checked against the same copy of the order, and its status
+// goes along with the matched version so the order service can reject the
+// change when the order moved on in between, which answers 409 too.
+func (h *handlerV1) transitionOrder(c *gin.Context, to string) {
+	var body models.OrderTransition
+	if !h.bindOptionalJSON(c, &pb.TransitionOrderReq{}, &body) {
+		return
+	}
+
+	id := c.Param("id")
+	ctx, cancel := h.requestContext(c)
+	defer cancel()
+
+	current, ok := h.loadOrder(ctx, c, id)
+	if !ok {
+		return
+	}
+	expected, ok := h.matchVersion(c, "order", current)
+	if !ok {
+		return
+	}
+
+	// orders created before statuses were tracked are pending
+	from := current.Status
+	if from == "" {
+		from = models.OrderStatusPending
+	}
+	if !canTransition(from, to) {
+		next := "none, the status is final"
+		if allowed := orderTransitions[from]; len(allowed) > 0 {
+			next = strings.Join(allowed, ", ")
+		}
+		response.Error(c, http.StatusConflict, models.ErrCodeConflict, "order is "+from+" and can't become "+to,
+			models.ErrorDetail{Field: "status", Description: "allowed next statuses: " + next})
+		h.requestLog(c).Warn("illegal order status transition", l.String("from", from), l.String("to", to))
+		return
+	}
+
+	req := &pb.TransitionOrderReq{
+		Id:         id,
+		FromStatus: current.Status,
+		ToStatus:   to,
+		Reason:     body.Reason,
+		// the order service compares it with the stored version, so a write
+		// landing after the If-Match check fails instead of being overwritten
+		ExpectedUpdatedAt: expected,
+	}
+	if claims, ok := middleware.GetClaims(c); ok {
+		req.ChangedBy = claims.UserID()
+	}
+
+	changed, err := h.serviceManager.OrderService().TransitionOrder(ctx, req)
+	if status.Code(err) == codes.FailedPrecondition {
+		// the order changed since it was read, the move is judged on stale state
+		response.Error(c, http.StatusConflict, models.ErrCodeConflict, "order status changed meanwhile, fetch it again and retry")
+		h.requestLog(c).Warn("order status changed during transition", l.Error(err), l.String("from", from), l.String("to", to))
+		return
+	}
+	if err != nil {
+		h.handleGRPCError(c, err, "failed to change order status")
+		return
+	}
+
+	setETag(c, changed)
+	codec.Render(c, http.StatusOK, changed)
+}
+
+// PayOrder ...
+// @Summary PayOrder
+// @Description This API for marking a pending order as paid
+// @Tags Order
+// @Security BearerAuth
+// @Accept  json
+// @Produce  json
+// @Param id path string true "ID"
+// @Param If-Match header string false "ETag of the version the change is based on"
+// @Param Transition body models.OrderTransition false "orderTransitionRequest"
+// @Success 200 {object} models.Order
+// @Failure 400 {object} models.StandardErrorModel
+// @Failure 401 {object} models.StandardErrorModel
+// @Failure 403 {object} models.StandardErrorModel
+// @Failure 404 {object} models.StandardErrorModel
+// @Failure 409 {object} models.StandardErrorModel
+// @Failure 412 {object} models.StandardErrorModel
+// @Failure 422 {object} models.StandardErrorModel
+// @Failure 500 {object} models.StandardErrorModel
+// @Router /v1/orders/{id}/pay [post]
+func (h *handlerV1) PayOrder(c *gin.Context) {
+	h.transitionOrder(c, models.OrderStatusPaid)
+}
+
+// ShipOrder ...
+// @Summary ShipOrder
+// @Description This API for marking a paid order as shipped
+// @Tags Order
+// @Security BearerAuth
+// @Accept  json
+// @Produce  json
+// @Param id path string true "ID"
+// @Param If-Match header string false "ETag of the version the change is based on"
+// @Param Transition body models.OrderTransition false "orderTransitionRequest"
+// @Success 200 {object} models.Order
+// @Failure 400 {object} models.StandardErrorModel
+// @Failure 401 {object} models.StandardErrorModel
+// @Failure 403 {object} models.StandardErrorModel
+// @Failure 404 {object} models.StandardErrorModel
+// @Failure 409 {object} models.StandardErrorModel
+// @Failure 412 {object} models.StandardErrorModel
+// @Failure 422 {object} models.StandardErrorModel
+// @Failure 500 {object} models.StandardErrorModel
+// @Router /v1/orders/{id}/ship [post]
+func (h *handlerV1) ShipOrder(c *gin.Context) {
+	h.transitionOrder(c, models.OrderStatusShipped)
+}
+
+// DeliverOrder ...
+// @Summary DeliverOrder
+// @Description This API for marking a shipped order as delivered
+// @Tags Order
+// @Security BearerAuth
+// @Accept  json
+// @Produce  json
+// @Param id path string true "ID"
+// @Param If-Match header string false "ETag of the version the change is based on"
+// @Param Transition body models.OrderTransition false "orderTransitionRequest"
+// @Success 200 {object} models.Order
+// @Failure 400 {object} models.StandardErrorModel
+// @Failure 401 {object} models.StandardErrorModel
+// @Failure 403 {object} models.StandardErrorModel
+// @Failure 404 {object} models.StandardErrorModel
+// @Failure 409 {object} models.StandardErrorModel
+// @Failure 412 {object} models.StandardErrorModel
+// @Failure 422 {object} models.StandardErrorModel
+// @Failure 500 {object} models.StandardErrorModel
+// @Router /v1/orders/{id}/deliver [post]
+func (h *handlerV1) DeliverOrder(c *gin.Context) {
+	h.transitionOrder(c, models.OrderStatusDelivered)
+}
+
+// CancelOrder ...
+// @Summary CancelOrder
+// @Description This API for cancelling a pending or paid order
+// @Tags Order
+// @Security BearerAuth
+// @Accept  json
+// @Produce  json
+// @Param id path string true "ID"
+// @Param If-Match header string false "ETag of the version the change is based on"
+// @Param Transition body models.OrderTransition false "orderTransitionRequest"
+// @Success 200 {object} models.Order
+// @Failure 400 {object} models.StandardErrorModel
+// @Failure 401 {object} models.StandardErrorModel
+// @Failure 403 {object} models.StandardErrorModel
+// @Failure 404 {object} models.StandardErrorModel
+// @Failure 409 {object} models.StandardErrorModel
+// @Failure 412 {object} models.StandardErrorModel
+// @Failure 422 {object} models.StandardErrorModel
+// @Failure 500 {object} models.StandardErrorModel
+// @Router /v1/orders/{id}/cancel [post]
+func (h *handlerV1) CancelOrder(c *gin.Context) {
+	h.transitionOrder(c, models.OrderStatusCancelled)
+}
+
+// RefundOrder ...
+// @Summary RefundOrder
+// @Description This API for refunding a paid or delivered order
+// @Tags Order
+// @Security BearerAuth
+// @Accept  json
+// @Produce  json
+// @Param id path string true "ID"
+// @Param If-Match header string false "ETag of the version the change is based on"
+// @Param Transition body models.OrderTransition false "orderTransitionRequest"
+// @Success 200 {object} models.Order
+// @Failure 400 {object} models.StandardErrorModel
+// @Failure 401 {object} models.StandardErrorModel
+// @Failure 403 {object} models.StandardErrorModel
+// @Failure 404 {object} models.StandardErrorModel
+// @Failure 409 {object} models.StandardErrorModel
+// @Failure 412 {object} models.StandardErrorModel
+// @Failure 422 {object} models.StandardErrorModel
+// @Failure 500 {object} models.StandardErrorModel
+// @Router /v1/orders/{id}/refund [post]
+func (h *handlerV1) RefundOrder(c *gin.Context) {
+	h.transitionOrder(c, models.OrderStatusRefunded)
+}
+
+// GetOrderStatusHistory ...
+// @Summary GetOrderStatusHistory
+// @Description This API for getting the status changes of an order
+// @Tags Order
+// @Security BearerAuth
+// @Produce  json
+// @Param id path string true "ID"
+// @Success 200 {object} models.OrderStatusHistory
+// @Failure 401 {object} models.StandardErrorModel
+// @Failure 403 {object} models.StandardErrorModel
+// @Failure 404 {object} models.StandardErrorModel
+// @Failure 500 {object} models.StandardErrorModel
+// @Router /v1/orders/{id}/history [get]
+func (h *handlerV1) GetOrderStatusHistory(c *gin.Context) {
+	ctx, cancel := h.requestContext(c)
+	defer cancel()
+
+	if _, ok := h.loadOrder(ctx, c, c.Param("id")); !ok {
+		return
+	}
+
+	response, err := h.serviceManager.OrderService().GetOrderStatusHistory(ctx, &pb.GetOrderByIdReq{Id: c.Param("id")})
+	if err != nil {
+		h.handleGRPCError(c, err, "failed to get order status history")
+		return
+	}
+
+	codec.Render(c, http.StatusOK, response)
+}
@@ -0,0 +1,106 @@
+package v1
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/protobuf/proto"
+
+	"github.com/muhriddinsalohiddin/online_store_api/api/codec"
+	"github.com/muhriddinsalohiddin/online_store_api/api/handlers/models"
+	"github.com/muhriddinsalohiddin/online_store_api/pkg/utils"
+)
+
+// totalCountHeader carries the total number of items of a listing
+const totalCountHeader = "X-Total-Count"
+
+// listResponse is implemented by every List*Resp message
+type listResponse interface {
+	proto.Message
+	GetCount() int64
+	GetNextCursor() string
+}
+
+// renderList writes a list response wrapped in the pagination envelope, with
+// RFC 8288 Link headers for the neighbouring pages and X-Total-Count. extra
+// fields replace or complete the ones of resp.
+func (h *handlerV1) renderList(c *gin.Context, params *utils.QueryParams, resp listResponse, extra map[string]interface{}) {
+	total := resp.GetCount()
+
+	p := models.Pagination{
+		Page:  params.Page,
+		Limit: params.Limit,
+		Total: total,
+	}
+	if params.Limit > 0 {
+		p.TotalPages = (total + params.Limit - 1) / params.Limit
+	}
+	if params.Cursor != "" {
+		p.HasNext = resp.GetNextCursor() != ""
+	} else {
+		p.HasNext = params.Page*params.Limit < total
+	}
+
+	c.Header(totalCountHeader, strconv.FormatInt(total, 10))
+	if links := pageLinks(c.Request.URL, p, resp.GetNextCursor()); len(links) > 0 {
+		c.Header("Link", strings.Join(links, ", "))
+	}
+
+	fields := map[string]interface{}{"pagination": p}
+	for name, value := range extra {
+		fields[name] = value
+	}
+	codec.RenderEnvelope(c, http.StatusOK, resp, fields)
+}
+
+// pageLinks builds the first/prev/next/last links relative to the request
+// url. Cursor listings only link to the first and next pages.
+func pageLinks(u *url.URL, p models.Pagination, nextCursor string) []string {
+	link := func(rel string, set func(q url.Values)) string {
+		q := u.Query()
+		q.Del("cursor")
+		q.Del("page")
+		set(q)
+
+		ref := url.URL{Path: u.Path, RawQuery: q.Encode()}
+		return fmt.Sprintf("<%s>; rel=%q", ref.String(), rel)
+	}
+	page := func(n int64) func(q url.Values) {
+		return func(q url.Values) {
+			q.Set("page", strconv.FormatInt(n, 10))
+		}
+	}
+
+	if p.Page == 0 {
+		links := []string{link("first", page(1))}
+		if nextCursor != "" {
+			links = append(links, link("next", func(q url.Values) {
+				q.Set("cursor", nextCursor)
+			}))
+		}
+		return links
+	}
+
+	last := p.TotalPages
+	if last < 1 {
+		last = 1
+	}
+
+	links := []string{link("first", page(1))}
+	if p.Page > 1 {
+		prev := p.Page - 1
+		if prev > last {
+			prev = last
+		}
+		links = append(links, link("prev", page(prev)))
+	}
+	if p.HasNext {
+		links = append(links, link("next", page(p.Page+1)))
+	}
+
+	return append(links, link("last", page(last)))
+}
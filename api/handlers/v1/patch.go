@@ -0,0 +1,141 @@
+package v1
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
+	"github.com/golang/protobuf/proto"
+
+	"github.com/muhriddinsalohiddin/online_store_api/api/codec"
+	"github.com/muhriddinsalohiddin/online_store_api/api/handlers/models"
+	"github.com/muhriddinsalohiddin/online_store_api/api/response"
+)
+
+const (
+	mergePatchContentType = "application/merge-patch+json"
+	jsonPatchContentType  = "application/json-patch+json"
+)
+
+var (
+	errPatchNotObject = errors.New("merge patch must be a JSON object")
+	errPatchEmpty     = errors.New("merge patch sets no fields")
+)
+
+// bindMergePatch decodes an RFC 7396 merge patch into m and returns the
+// update mask of the fields it sets. Only the fields of the api model can be
+// patched, null resets a field to its zero value and only the supplied
+// fields are validated. It answers the client itself and reports whether the
+// handler may go on.
+func (h *handlerV1) bindMergePatch(c *gin.Context, m proto.Message, model interface{}) ([]string, bool) {
+	switch c.ContentType() {
+	case mergePatchContentType, binding.MIMEJSON, "":
+	case jsonPatchContentType:
+		response.Error(c, http.StatusUnsupportedMediaType, models.ErrCodeInvalidArgument, "JSON Patch is not supported, send an RFC 7396 merge patch as "+mergePatchContentType)
+		return nil, false
+	default:
+		response.Error(c, http.StatusUnsupportedMediaType, models.ErrCodeInvalidArgument, "Content-Type must be "+mergePatchContentType)
+		return nil, false
+	}
+
+	if c.Request.Body == nil {
+		h.handleBadRequest(c, codec.ErrEmptyBody, "failed to bind merge patch")
+		return nil, false
+	}
+	data, err := ioutil.ReadAll(c.Request.Body)
+	if err == nil && len(data) == 0 {
+		err = codec.ErrEmptyBody
+	}
+	if err != nil {
+		h.handleBadRequest(c, err, "failed to bind merge patch")
+		return nil, false
+	}
+
+	var patch map[string]json.RawMessage
+	if err := json.Unmarshal(data, &patch); err != nil || patch == nil {
+		h.handleBadRequest(c, errPatchNotObject, "failed to bind merge patch")
+		return nil, false
+	}
+
+	patchable := modelFields(model)
+	paths := make([]string, 0, len(patch))
+	fields := make([]string, 0, len(patch))
+	var unknown []string
+	for name := range patch {
+		field, ok := patchable[name]
+		if !ok {
+			unknown = append(unknown, name)
+			continue
+		}
+		paths = append(paths, name)
+		fields = append(fields, field)
+	}
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		h.handleBadRequest(c, fmt.Errorf("unknown or read-only fields: %s", strings.Join(unknown, ", ")), "failed to bind merge patch")
+		return nil, false
+	}
+	if len(paths) == 0 {
+		h.handleBadRequest(c, errPatchEmpty, "failed to bind merge patch")
+		return nil, false
+	}
+	sort.Strings(paths)
+
+	if err := codec.Unmarshal(data, m); err != nil {
+		h.handleBadRequest(c, err, "failed to bind merge patch")
+		return nil, false
+	}
+
+	encoded, err := json.Marshal(m)
+	if err == nil {
+		err = json.Unmarshal(encoded, model)
+	}
+	if err == nil {
+		err = validatePartial(model, fields)
+	}
+	if err != nil {
+		h.handleBindError(c, err)
+		return nil, false
+	}
+
+	return paths, true
+}
+
+// validatePartial runs the binding rules of the given struct fields only
+func validatePartial(model interface{}, fields []string) error {
+	v, ok := binding.Validator.Engine().(*validator.Validate)
+	if !ok {
+		return binding.Validator.ValidateStruct(model)
+	}
+
+	return v.StructPartial(model, fields...)
+}
+
+// modelFields maps the json names of the api model fields to their struct
+// field names
+func modelFields(model interface{}) map[string]string {
+	t := reflect.TypeOf(model)
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	fields := make(map[string]string, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		name := strings.SplitN(f.Tag.Get("json"), ",", 2)[0]
+		if name == "" || name == "-" {
+			continue
+		}
+		fields[name] = f.Name
+	}
+
+	return fields
+}
@@ -0,0 +1,323 @@
+package v1
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"github.com/muhriddinsalohiddin/online_store_api/api/handlers/models"
+	pbCatalog "github.com/muhriddinsalohiddin/online_store_api/genproto/catalog_service"
+	l "github.com/muhriddinsalohiddin/online_store_api/pkg/logger"
+	"github.com/muhriddinsalohiddin/online_store_api/pkg/utils"
+)
+
+// bookOrderingFields are the fields ListBooks can be sorted by
+var bookOrderingFields = map[string]bool{
+	"name":       true,
+	"created_at": true,
+	"updated_at": true,
+}
+
+// orderStatuses are the values ListOrders can be filtered by
+var orderStatuses = map[string]bool{
+	models.OrderStatusPending:   true,
+	models.OrderStatusPaid:      true,
+	models.OrderStatusShipped:   true,
+	models.OrderStatusDelivered: true,
+	models.OrderStatusCancelled: true,
+	models.OrderStatusRefunded:  true,
+}
+
+// category_match values of ListBooks
+const (
+	categoryMatchAny = "any"
+	categoryMatchAll = "all"
+)
+
+var categoryMatches = map[string]bool{
+	categoryMatchAny: true,
+	categoryMatchAll: true,
+}
+
+// deleted values of the list endpoints, soft deleted items are left out
+// unless asked for
+const (
+	deletedExclude = "exclude"
+	deletedInclude = "include"
+	deletedOnly    = "only"
+)
+
+var deletedScopes = map[string]bool{
+	deletedExclude: true,
+	deletedInclude: true,
+	deletedOnly:    true,
+}
+
+// typedBookParams travel in their own ListBookReq fields and are kept out of
+// the Filters map
+var typedBookParams = []string{"category", "category_match", "include_descendants", "expand", "deleted"}
+
+const (
+	maxFilterIDs  = 20
+	maxNamePrefix = 255
+	dateLayout    = "2006-01-02"
+)
+
+// filterParser validates the filter query params of the list endpoints,
+// collecting every problem in errStr the way utils.ParseQueryParams does.
+// Absent params come back as empty strings.
+type filterParser struct {
+	query  url.Values
+	errStr []string
+}
+
+func (p *filterParser) id(name string) string {
+	value := strings.TrimSpace(p.query.Get(name))
+	if value == "" {
+		return ""
+	}
+	if _, err := uuid.Parse(value); err != nil {
+		p.errStr = append(p.errStr, fmt.Sprintf("Invalid `%s` param, must be a valid UUID", name))
+		return ""
+	}
+
+	return value
+}
+
+// ids collects the UUIDs of a repeated or comma separated param
+func (p *filterParser) ids(name string) []string {
+	var ids []string
+	for _, value := range p.query[name] {
+		for _, id := range strings.Split(value, ",") {
+			if id = strings.TrimSpace(id); id == "" {
+				continue
+			}
+			if _, err := uuid.Parse(id); err != nil {
+				p.errStr = append(p.errStr, fmt.Sprintf("Invalid `%s` param, %q is not a valid UUID", name, id))
+				continue
+			}
+			ids = append(ids, id)
+		}
+	}
+
+	if len(ids) > maxFilterIDs {
+		p.errStr = append(p.errStr, fmt.Sprintf("Invalid `%s` param, at most %d values are allowed", name, maxFilterIDs))
+		return nil
+	}
+
+	return ids
+}
+
+// expand collects the related entities a response should embed
+func (p *filterParser) expand(allowed map[string]bool) map[string]bool {
+	var expand map[string]bool
+	for _, value := range p.query["expand"] {
+		for _, name := range strings.Split(value, ",") {
+			if name = strings.TrimSpace(name); name == "" {
+				continue
+			}
+			if !allowed[name] {
+				p.errStr = append(p.errStr, fmt.Sprintf("Invalid `expand` value `%s`, allowed: %s", name, allowedList(allowed)))
+				continue
+			}
+			if expand == nil {
+				expand = make(map[string]bool)
+			}
+			expand[name] = true
+		}
+	}
+
+	return expand
+}
+
+// absent rejects params a route doesn't support
+func (p *filterParser) absent(reason string, names ...string) {
+	for _, name := range names {
+		if _, ok := p.query[name]; ok {
+			p.errStr = append(p.errStr, fmt.Sprintf("Invalid `%s` param, %s", name, reason))
+		}
+	}
+}
+
+func (p *filterParser) flag(name string) bool {
+	value := strings.TrimSpace(p.query.Get(name))
+	if value == "" {
+		return false
+	}
+
+	flag, err := strconv.ParseBool(value)
+	if err != nil {
+		p.errStr = append(p.errStr, fmt.Sprintf("Invalid `%s` param, must be true or false", name))
+	}
+
+	return flag
+}
+
+func (p *filterParser) prefix(name string) string {
+	value := strings.TrimSpace(p.query.Get(name))
+	if len(value) > maxNamePrefix {
+		p.errStr = append(p.errStr, fmt.Sprintf("Invalid `%s` param, must be at most %d characters long", name, maxNamePrefix))
+		return ""
+	}
+
+	return value
+}
+
+func (p *filterParser) oneOf(name string, allowed map[string]bool) string {
+	value := strings.TrimSpace(p.query.Get(name))
+	if value == "" {
+		return ""
+	}
+	if !allowed[value] {
+		p.errStr = append(p.errStr, fmt.Sprintf("Invalid `%s` param, allowed: %s", name, allowedList(allowed)))
+		return ""
+	}
+
+	return value
+}
+
+// timestamp accepts RFC 3339 timestamps and plain dates, a plain date used as the
+// upper bound of a range covers the whole day
+func (p *filterParser) timestamp(name string, upper bool) (time.Time, bool) {
+	value := strings.TrimSpace(p.query.Get(name))
+	if value == "" {
+		return time.Time{}, false
+	}
+
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t.UTC(), true
+	}
+	if t, err := time.Parse(dateLayout, value); err == nil {
+		if upper {
+			t = t.AddDate(0, 0, 1).Add(-time.Nanosecond)
+		}
+		return t, true
+	}
+
+	p.errStr = append(p.errStr, fmt.Sprintf("Invalid `%s` param, must be an RFC 3339 timestamp or a YYYY-MM-DD date", name))
+	return time.Time{}, false
+}
+
+// timeRange parses the from/to pair of a date range and checks its order
+func (p *filterParser) timeRange(fromName, toName string) (string, string) {
+	var fromStr, toStr string
+
+	from, hasFrom := p.timestamp(fromName, false)
+	to, hasTo := p.timestamp(toName, true)
+	if hasFrom {
+		fromStr = from.Format(time.RFC3339Nano)
+	}
+	if hasTo {
+		toStr = to.Format(time.RFC3339Nano)
+	}
+	if hasFrom && hasTo && to.Before(from) {
+		p.errStr = append(p.errStr, fmt.Sprintf("Invalid date range, `%s` is before `%s`", toName, fromName))
+	}
+
+	return fromStr, toStr
+}
+
+// parseOrdering turns ?ordering=name,-created_at into sort fields, a leading
+// '-' sorts descending. Fields that aren't allowed are reported in errStr.
+func parseOrdering(ordering []string, allowed map[string]bool) ([]*pbCatalog.SortField, []string) {
+	var (
+		fields []*pbCatalog.SortField
+		errStr []string
+		seen   = make(map[string]bool, len(ordering))
+	)
+
+	for _, raw := range ordering {
+		name := strings.TrimSpace(raw)
+		if name == "" {
+			continue
+		}
+
+		desc := strings.HasPrefix(name, "-")
+		name = strings.TrimPrefix(name, "-")
+		if !allowed[name] {
+			errStr = append(errStr, fmt.Sprintf("Invalid `ordering` field `%s`, allowed: %s", name, allowedList(allowed)))
+			continue
+		}
+		if seen[name] {
+			continue
+		}
+		seen[name] = true
+
+		fields = append(fields, &pbCatalog.SortField{Field: name, Desc: desc})
+	}
+
+	return fields, errStr
+}
+
+func allowedList(allowed map[string]bool) string {
+	names := make([]string, 0, len(allowed))
+	for name := range allowed {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return strings.Join(names, ", ")
+}
+
+// pagingParams move through a listing or only shape its items, every other
+// query param identifies the listing and is bound into the cursor scope
+var pagingParams = map[string]bool{
+	"cursor": true,
+	"page":   true,
+	"limit":  true,
+	"expand": true,
+}
+
+func cursorScope(resource string, query url.Values) string {
+	scoped := make(url.Values, len(query))
+	for key, value := range query {
+		if !pagingParams[key] {
+			scoped[key] = value
+		}
+	}
+
+	return resource + "?" + scoped.Encode()
+}
+
+// parsePaging enforces the configured maximum limit and, in cursor mode,
+// verifies the cursor and returns the backend position it wraps. Page is
+// cleared in cursor mode.
+func (h *handlerV1) parsePaging(c *gin.Context, resource string, params *utils.QueryParams) (string, []string) {
+	var errStr []string
+	if h.cfg.MaxPageLimit > 0 && params.Limit > int64(h.cfg.MaxPageLimit) {
+		errStr = append(errStr, fmt.Sprintf("Invalid `limit` param, must be at most %d", h.cfg.MaxPageLimit))
+	}
+	if params.Cursor == "" {
+		return "", errStr
+	}
+
+	params.Page = 0
+	query := c.Request.URL.Query()
+	if _, ok := query["page"]; ok {
+		errStr = append(errStr, "Invalid `page` param, can't be combined with `cursor`")
+	}
+
+	position, err := h.cursors.Verify(params.Cursor, cursorScope(resource, query))
+	if err != nil {
+		errStr = append(errStr, "Invalid `cursor` param")
+		h.requestLog(c).Debug("rejected cursor", l.Error(err))
+	}
+
+	return position, errStr
+}
+
+// nextCursor signs the position a backend returned for the next page, an
+// empty position means the listing is exhausted
+func (h *handlerV1) nextCursor(c *gin.Context, resource, position string) string {
+	if position == "" {
+		return ""
+	}
+
+	return h.cursors.Sign(cursorScope(resource, c.Request.URL.Query()), position)
+}
@@ -0,0 +1,253 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/muhriddinsalohiddin/online_store_api/api/codec"
+	"github.com/muhriddinsalohiddin/online_store_api/api/handlers/models"
+	"github.com/muhriddinsalohiddin/online_store_api/api/middleware"
+	"github.com/muhriddinsalohiddin/online_store_api/api/response"
+	pbCatalog "github.com/muhriddinsalohiddin/online_store_api/genproto/catalog_service"
+	pbOrder "github.com/muhriddinsalohiddin/online_store_api/genproto/order_service"
+	"github.com/muhriddinsalohiddin/online_store_api/pkg/auth"
+)
+
+// allowDeleted checks a list asking for soft deleted items may show them,
+// only callers holding perm see them: catalog writers for the catalog and
+// order managers for orders, whose trash mixes every user. It answers the
+// client itself and reports whether the handler may go on.
+func (h *handlerV1) allowDeleted(c *gin.Context, deleted string, perm auth.Permission) bool {
+	if deleted == "" || deleted == deletedExclude {
+		return true
+	}
+
+	claims, ok := middleware.GetClaims(c)
+	if !ok {
+		middleware.Unauthorized(c, "authentication required to list deleted items")
+		return false
+	}
+	if !claims.HasPermission(perm) {
+		response.Error(c, http.StatusForbidden, models.ErrCodePermissionDenied, "permission denied to list deleted items")
+		return false
+	}
+
+	return true
+}
+
+// RestoreBook ...
+// @Summary RestoreBook
+// @Description This API for restoring a deleted book
+// @Tags book
+// @Security BearerAuth
+// @Produce  json
+// @Param id path string true "ID"
+// @Success 200 {object} models.Book
+// @Header 200 {string} ETag "Version of the resource"
+// @Failure 401 {object} models.StandardErrorModel
+// @Failure 403 {object} models.StandardErrorModel
+// @Failure 404 {object} models.StandardErrorModel
+// @Failure 500 {object} models.StandardErrorModel
+// @Router /v1/books/{id}/restore [post]
+func (h *handlerV1) RestoreBook(c *gin.Context) {
+	ctx, cancel := h.requestContext(c)
+	defer cancel()
+
+	response, err := h.serviceManager.CatalogService().RestoreBook(ctx, &pbCatalog.GetBookByIdReq{Id: c.Param("id")})
+	if err != nil {
+		h.handleGRPCError(c, err, "failed to restore book")
+		return
+	}
+
+	setETag(c, response)
+	codec.Render(c, http.StatusOK, response)
+}
+
+// PurgeBook ...
+// @Summary PurgeBook
+// @Description This API for permanently deleting a book, it can't be restored afterwards
+// @Tags book
+// @Security BearerAuth
+// @Produce  json
+// @Param id path string true "ID"
+// @Success 200
+// @Failure 401 {object} models.StandardErrorModel
+// @Failure 403 {object} models.StandardErrorModel
+// @Failure 404 {object} models.StandardErrorModel
+// @Failure 500 {object} models.StandardErrorModel
+// @Router /v1/books/{id}/purge [delete]
+func (h *handlerV1) PurgeBook(c *gin.Context) {
+	ctx, cancel := h.requestContext(c)
+	defer cancel()
+
+	response, err := h.serviceManager.CatalogService().PurgeBook(ctx, &pbCatalog.GetBookByIdReq{Id: c.Param("id")})
+	if err != nil {
+		h.handleGRPCError(c, err, "failed to purge book")
+		return
+	}
+
+	codec.Render(c, http.StatusOK, response)
+}
+
+// RestoreAuthor ...
+// @Summary RestoreAuthor
+// @Description This API for restoring a deleted author
+// @Tags author
+// @Security BearerAuth
+// @Produce  json
+// @Param id path string true "ID"
+// @Success 200 {object} models.Author
+// @Header 200 {string} ETag "Version of the resource"
+// @Failure 401 {object} models.StandardErrorModel
+// @Failure 403 {object} models.StandardErrorModel
+// @Failure 404 {object} models.StandardErrorModel
+// @Failure 500 {object} models.StandardErrorModel
+// @Router /v1/authors/{id}/restore [post]
+func (h *handlerV1) RestoreAuthor(c *gin.Context) {
+	ctx, cancel := h.requestContext(c)
+	defer cancel()
+
+	response, err := h.serviceManager.CatalogService().RestoreAuthor(ctx, &pbCatalog.GetAuthorByIdReq{Id: c.Param("id")})
+	if err != nil {
+		h.handleGRPCError(c, err, "failed to restore author")
+		return
+	}
+
+	setETag(c, response)
+	codec.Render(c, http.StatusOK, response)
+}
+
+// PurgeAuthor ...
+// @Summary PurgeAuthor
+// @Description This API for permanently deleting a author, it can't be restored afterwards
+// @Tags author
+// @Security BearerAuth
+// @Produce  json
+// @Param id path string true "ID"
+// @Success 200
+// @Failure 401 {object} models.StandardErrorModel
+// @Failure 403 {object} models.StandardErrorModel
+// @Failure 404 {object} models.StandardErrorModel
+// @Failure 500 {object} models.StandardErrorModel
+// @Router /v1/authors/{id}/purge [delete]
+func (h *handlerV1) PurgeAuthor(c *gin.Context) {
+	ctx, cancel := h.requestContext(c)
+	defer cancel()
+
+	response, err := h.serviceManager.CatalogService().PurgeAuthor(ctx, &pbCatalog.GetAuthorByIdReq{Id: c.Param("id")})
+	if err != nil {
+		h.handleGRPCError(c, err, "failed to purge author")
+		return
+	}
+
+	codec.Render(c, http.StatusOK, response)
+}
+
+// RestoreCategory ...
+// @Summary RestoreCategory
+// @Description This API for restoring a deleted category
+// @Tags category
+// @Security BearerAuth
+// @Produce  json
+// @Param id path string true "ID"
+// @Success 200 {object} models.Category
+// @Header 200 {string} ETag "Version of the resource"
+// @Failure 401 {object} models.StandardErrorModel
+// @Failure 403 {object} models.StandardErrorModel
+// @Failure 404 {object} models.StandardErrorModel
+// @Failure 500 {object} models.StandardErrorModel
+// @Router /v1/categories/{id}/restore [post]
+func (h *handlerV1) RestoreCategory(c *gin.Context) {
+	ctx, cancel := h.requestContext(c)
+	defer cancel()
+
+	response, err := h.serviceManager.CatalogService().RestoreCategory(ctx, &pbCatalog.GetCategoryByIdReq{Id: c.Param("id")})
+	if err != nil {
+		h.handleGRPCError(c, err, "failed to restore category")
+		return
+	}
+
+	setETag(c, response)
+	codec.Render(c, http.StatusOK, response)
+}
+
+// PurgeCategory ...
+// @Summary PurgeCategory
+// @Description This API for permanently deleting a category, it can't be restored afterwards
+// @Tags category
+// @Security BearerAuth
+// @Produce  json
+// @Param id path string true "ID"
+// @Success 200
+// @Failure 401 {object} models.StandardErrorModel
+// @Failure 403 {object} models.StandardErrorModel
+// @Failure 404 {object} models.StandardErrorModel
+// @Failure 500 {object} models.StandardErrorModel
+// @Router /v1/categories/{id}/purge [delete]
+func (h *handlerV1) PurgeCategory(c *gin.Context) {
+	ctx, cancel := h.requestContext(c)
+	defer cancel()
+
+	response, err := h.serviceManager.CatalogService().PurgeCategory(ctx, &pbCatalog.GetCategoryByIdReq{Id: c.Param("id")})
+	if err != nil {
+		h.handleGRPCError(c, err, "failed to purge category")
+		return
+	}
+
+	codec.Render(c, http.StatusOK, response)
+}
+
+// RestoreOrder ...
+// @Summary RestoreOrder
+// @Description This API for restoring a deleted order, it's limited to admins
+// @Tags Order
+// @Security BearerAuth
+// @Produce  json
+// @Param id path string true "ID"
+// @Success 200 {object} models.Order
+// @Header 200 {string} ETag "Version of the resource"
+// @Failure 401 {object} models.StandardErrorModel
+// @Failure 403 {object} models.StandardErrorModel
+// @Failure 404 {object} models.StandardErrorModel
+// @Failure 500 {object} models.StandardErrorModel
+// @Router /v1/orders/{id}/restore [post]
+func (h *handlerV1) RestoreOrder(c *gin.Context) {
+	ctx, cancel := h.requestContext(c)
+	defer cancel()
+
+	response, err := h.serviceManager.OrderService().RestoreOrder(ctx, &pbOrder.GetOrderByIdReq{Id: c.Param("id")})
+	if err != nil {
+		h.handleGRPCError(c, err, "failed to restore order")
+		return
+	}
+
+	setETag(c, response)
+	codec.Render(c, http.StatusOK, response)
+}
+
+// PurgeOrder ...
+// @Summary PurgeOrder
+// @Description This API for permanently deleting a order, it can't be restored afterwards
+// @Tags Order
+// @Security BearerAuth
+// @Produce  json
+// @Param id path string true "ID"
+// @Success 200
+// @Failure 401 {object} models.StandardErrorModel
+// @Failure 403 {object} models.StandardErrorModel
+// @Failure 404 {object} models.StandardErrorModel
+// @Failure 500 {object} models.StandardErrorModel
+// @Router /v1/orders/{id}/purge [delete]
+func (h *handlerV1) PurgeOrder(c *gin.Context) {
+	ctx, cancel := h.requestContext(c)
+	defer cancel()
+
+	response, err := h.serviceManager.OrderService().PurgeOrder(ctx, &pbOrder.GetOrderByIdReq{Id: c.Param("id")})
+	if err != nil {
+		h.handleGRPCError(c, err, "failed to purge order")
+		return
+	}
+
+	codec.Render(c, http.StatusOK, response)
+}
@@ -0,0 +1,234 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/muhriddinsalohiddin/online_store_api/api/codec"
+	"github.com/muhriddinsalohiddin/online_store_api/api/handlers/models"
+	"github.com/muhriddinsalohiddin/online_store_api/api/response"
+	pbCatalog "github.com/muhriddinsalohiddin/online_store_api/genproto/catalog_service"
+	pbOrder "github.com/muhriddinsalohiddin/online_store_api/genproto/order_service"
+	l "github.com/muhriddinsalohiddin/online_store_api/pkg/logger"
+)
+
+var registerValidatorOnce sync.Once
+
+// registerValidator makes validation errors name fields by their json names
+func registerValidator() {
+	registerValidatorOnce.Do(func() {
+		v, ok := binding.Validator.Engine().(*validator.Validate)
+		if !ok {
+			return
+		}
+
+		v.RegisterTagNameFunc(func(field reflect.StructField) string {
+			name := strings.SplitN(field.Tag.Get("json"), ",", 2)[0]
+			if name == "-" {
+				return ""
+			}
+			return name
+		})
+	})
+}
+
+// bindJSON decodes the body into the protobuf message m, which rejects
+// unknown fields, then copies the writable fields onto the api model and
+// validates them. It answers the client itself and reports whether the
+// handler may go on.
+func (h *handlerV1) bindJSON(c *gin.Context, m proto.Message, model interface{}) bool {
+	if err := codec.Bind(c, m); err != nil {
+		h.handleBadRequest(c, err, "failed to bind json")
+		return false
+	}
+
+	return h.validateBound(c, m, model)
+}
+
+// bindOptionalJSON is bindJSON for actions whose body may be left out, an
+// empty body leaves the model as it is
+func (h *handlerV1) bindOptionalJSON(c *gin.Context, m proto.Message, model interface{}) bool {
+	err := codec.Bind(c, m)
+	if errors.Is(err, codec.ErrEmptyBody) {
+		return true
+	}
+	if err != nil {
+		h.handleBadRequest(c, err, "failed to bind json")
+		return false
+	}
+
+	return h.validateBound(c, m, model)
+}
+
+// validateBound copies the decoded message onto the api model and validates it
+func (h *handlerV1) validateBound(c *gin.Context, m proto.Message, model interface{}) bool {
+	// generated messages carry the proto names as json tags, the same the
+	// api models use
+	data, err := json.Marshal(m)
+	if err == nil {
+		err = json.Unmarshal(data, model)
+	}
+	if err == nil {
+		err = binding.Validator.ValidateStruct(model)
+	}
+	if err != nil {
+		h.handleBindError(c, err)
+		return false
+	}
+
+	return true
+}
+
+// handleBindError answers 422 listing every rule violation when the payload
+// failed validation and 400 when it isn't valid json at all
+func (h *handlerV1) handleBindError(c *gin.Context, err error) {
+	var verrs validator.ValidationErrors
+	if !errors.As(err, &verrs) {
+		h.handleBadRequest(c, err, "failed to bind json")
+		return
+	}
+
+	details := make([]models.ErrorDetail, 0, len(verrs))
+	for _, fe := range verrs {
+		details = append(details, models.ErrorDetail{
+			Field:       fe.Field(),
+			Description: describeViolation(fe),
+		})
+	}
+
+	h.handleValidationFailed(c, details)
+}
+
+func (h *handlerV1) handleValidationFailed(c *gin.Context, details []models.ErrorDetail) {
+	response.Error(c, http.StatusUnprocessableEntity, models.ErrCodeValidationFailed, "validation failed", details...)
+	h.requestLog(c).Warn("validation failed", l.Any("violations", details))
+}
+
+func describeViolation(fe validator.FieldError) string {
+	switch fe.Tag() {
+	case "required":
+		return "is required"
+	case "uuid":
+		return "must be a valid UUID"
+	case "max":
+		if fe.Kind() == reflect.Slice {
+			return fmt.Sprintf("must have at most %s items", fe.Param())
+		}
+		return fmt.Sprintf("must be at most %s characters long", fe.Param())
+	case "min":
+		if fe.Kind() == reflect.Slice {
+			return fmt.Sprintf("must have at least %s items", fe.Param())
+		}
+		return fmt.Sprintf("must be at least %s characters long", fe.Param())
+	default:
+		return fmt.Sprintf("failed the %q rule", fe.Tag())
+	}
+}
+
+// reference is an id in a payload that must point to an existing entity
+type reference struct {
+	field  string
+	entity string
+	id     string
+}
+
+func authorRef(field, id string) reference {
+	return reference{field: field, entity: "author", id: id}
+}
+
+func categoryRef(field, id string) reference {
+	return reference{field: field, entity: "category", id: id}
+}
+
+func bookRef(field, id string) reference {
+	return reference{field: field, entity: "book", id: id}
+}
+
+func categoryRefs(field string, ids []string) []reference {
+	refs := make([]reference, 0, len(ids))
+	for i, id := range ids {
+		refs = append(refs, categoryRef(fmt.Sprintf("%s[%d]", field, i), id))
+	}
+	return refs
+}
+
+// checkReferences looks every referenced entity up concurrently. It answers
+// 422 listing the missing ones, or the upstream error, and reports whether
+// the handler may go on.
+func (h *handlerV1) checkReferences(ctx context.Context, c *gin.Context, refs ...reference) bool {
+	var (
+		mu       sync.Mutex
+		wg       sync.WaitGroup
+		details  []models.ErrorDetail
+		firstErr error
+	)
+
+	for _, ref := range refs {
+		if ref.id == "" {
+			continue
+		}
+
+		wg.Add(1)
+		go func(ref reference) {
+			defer wg.Done()
+
+			_, err := h.lookup(ctx, ref)
+			if err == nil {
+				return
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			if status.Code(err) == codes.NotFound {
+				details = append(details, models.ErrorDetail{
+					Field:       ref.field,
+					Description: ref.entity + " not found",
+				})
+				return
+			}
+			if firstErr == nil {
+				firstErr = err
+			}
+		}(ref)
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		h.handleGRPCError(c, firstErr, "failed to look up references")
+		return false
+	}
+	if len(details) > 0 {
+		h.handleValidationFailed(c, details)
+		return false
+	}
+
+	return true
+}
+
+func (h *handlerV1) lookup(ctx context.Context, ref reference) (versioned, error) {
+	switch ref.entity {
+	case "author":
+		return h.serviceManager.CatalogService().GetAuthorById(ctx, &pbCatalog.GetAuthorByIdReq{Id: ref.id})
+	case "category":
+		return h.serviceManager.CatalogService().GetCategoryById(ctx, &pbCatalog.GetCategoryByIdReq{Id: ref.id})
+	case "book":
+		return h.serviceManager.CatalogService().GetBookById(ctx, &pbCatalog.GetBookByIdReq{Id: ref.id})
+	case "order":
+		return h.serviceManager.OrderService().GetOrderById(ctx, &pbOrder.GetOrderByIdReq{Id: ref.id})
+	default:
+		return nil, fmt.Errorf("unknown reference entity %q", ref.entity)
+	}
+}
@@ -0,0 +1,140 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/muhriddinsalohiddin/online_store_api/config"
+	"github.com/muhriddinsalohiddin/online_store_api/pkg/logger"
+)
+
+const (
+	redacted = "[REDACTED]"
+	// maxLoggedBody caps how much of a request body is buffered for the log
+	maxLoggedBody = 64 << 10
+)
+
+// AccessLog writes one structured line per request through pkg/logger.
+// Successful requests are sampled by cfg.AccessLogSampleRate, client and
+// server errors are always logged, together with the upstream errors the
+// handlers attached with c.Error.
+func AccessLog(log logger.Logger, cfg config.Config) gin.HandlerFunc {
+	redactHeaders := make(map[string]bool, len(cfg.AccessLogRedactHeaders))
+	for _, h := range cfg.AccessLogRedactHeaders {
+		redactHeaders[http.CanonicalHeaderKey(h)] = true
+	}
+	redactFields := make(map[string]bool, len(cfg.AccessLogRedactFields))
+	for _, f := range cfg.AccessLogRedactFields {
+		redactFields[strings.ToLower(f)] = true
+	}
+
+	return func(c *gin.Context) {
+		start := time.Now()
+
+		var body []byte
+		if cfg.AccessLogBody && c.Request.Body != nil {
+			body, _ = ioutil.ReadAll(io.LimitReader(c.Request.Body, maxLoggedBody))
+			c.Request.Body = ioutil.NopCloser(io.MultiReader(bytes.NewReader(body), c.Request.Body))
+		}
+
+		c.Next()
+
+		status := c.Writer.Status()
+		if status < http.StatusBadRequest && rand.Float64() >= cfg.AccessLogSampleRate {
+			return
+		}
+
+		route := c.FullPath()
+		if route == "" {
+			route = unmatchedRoute
+		}
+
+		fields := []logger.Field{
+			logger.String("method", c.Request.Method),
+			logger.String("route", route),
+			logger.String("path", c.Request.URL.Path),
+			logger.Int("status", status),
+			logger.Any("latency", time.Since(start)),
+			logger.Int("bytes", c.Writer.Size()),
+			logger.String("client_ip", c.ClientIP()),
+			logger.String("user_agent", c.Request.UserAgent()),
+			logger.Any("headers", redactHeaderValues(c.Request.Header, redactHeaders)),
+		}
+		if claims, ok := GetClaims(c); ok {
+			fields = append(fields, logger.String("user_id", claims.UserID()))
+		}
+		if len(body) > 0 {
+			fields = append(fields, logger.String("body", redactBody(body, redactFields)))
+		}
+		if len(c.Errors) > 0 {
+			fields = append(fields, logger.Any("errors", c.Errors.Errors()))
+		}
+
+		l := GetLogger(c, log)
+		switch {
+		case status >= http.StatusInternalServerError:
+			l.Error("request", fields...)
+		case status >= http.StatusBadRequest:
+			l.Warn("request", fields...)
+		default:
+			l.Info("request", fields...)
+		}
+	}
+}
+
+func redactHeaderValues(header http.Header, redact map[string]bool) map[string]string {
+	values := make(map[string]string, len(header))
+	for name, v := range header {
+		if redact[name] {
+			values[name] = redacted
+			continue
+		}
+		values[name] = strings.Join(v, ", ")
+	}
+
+	return values
+}
+
+// redactBody masks the configured fields at any depth of a JSON body,
+// bodies that aren't JSON are replaced by their size
+func redactBody(body []byte, fields map[string]bool) string {
+	var v interface{}
+	if err := json.Unmarshal(body, &v); err != nil {
+		return "[non-json body, " + strconv.Itoa(len(body)) + " bytes]"
+	}
+
+	out, err := json.Marshal(redactValue(v, fields))
+	if err != nil {
+		return redacted
+	}
+
+	return string(out)
+}
+
+func redactValue(v interface{}, fields map[string]bool) interface{} {
+	switch val := v.(type) {
+	case map[string]interface{}:
+		for k, item := range val {
+			if fields[strings.ToLower(k)] {
+				val[k] = redacted
+				continue
+			}
+			val[k] = redactValue(item, fields)
+		}
+	case []interface{}:
+		for i, item := range val {
+			val[i] = redactValue(item, fields)
+		}
+	}
+
+	return v
+}
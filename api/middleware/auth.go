@@ -0,0 +1,81 @@
+package middleware
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/muhriddinsalohiddin/online_store_api/api/handlers/models"
+	"github.com/muhriddinsalohiddin/online_store_api/api/response"
+	"github.com/muhriddinsalohiddin/online_store_api/pkg/auth"
+)
+
+// ClaimsKey is the gin context key the verified token claims are stored under
+const ClaimsKey = "claims"
+
+// Authenticate verifies the bearer token when one is sent and stores its claims
+// in the context. Requests without a token pass through anonymously, routes that
+// need a caller are guarded by RequirePermission.
+func Authenticate(keySet *auth.KeySet) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		header := c.GetHeader("Authorization")
+		if header == "" {
+			c.Next()
+			return
+		}
+
+		// the auth scheme is case insensitive (RFC 7235)
+		parts := strings.SplitN(strings.TrimSpace(header), " ", 2)
+		if len(parts) != 2 || !strings.EqualFold(parts[0], "Bearer") || strings.TrimSpace(parts[1]) == "" {
+			Unauthorized(c, "authorization header must be a bearer token")
+			return
+		}
+		token := strings.TrimSpace(parts[1])
+
+		claims, err := keySet.ParseToken(token)
+		if err != nil {
+			Unauthorized(c, "invalid or expired token")
+			return
+		}
+
+		c.Set(ClaimsKey, claims)
+		c.Next()
+	}
+}
+
+// RequirePermission rejects anonymous callers with 401 and callers whose role
+// doesn't grant the permission with 403
+func RequirePermission(p auth.Permission) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		claims, ok := GetClaims(c)
+		if !ok {
+			Unauthorized(c, "authentication required")
+			return
+		}
+
+		if !claims.HasPermission(p) {
+			response.Error(c, http.StatusForbidden, models.ErrCodePermissionDenied, "permission denied")
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// GetClaims returns the claims of the authenticated caller
+func GetClaims(c *gin.Context) (*auth.Claims, bool) {
+	v, ok := c.Get(ClaimsKey)
+	if !ok {
+		return nil, false
+	}
+
+	claims, ok := v.(*auth.Claims)
+	return claims, ok
+}
+
+// Unauthorized aborts with 401 and the bearer challenge
+func Unauthorized(c *gin.Context, message string) {
+	c.Header("WWW-Authenticate", `Bearer realm="online_store_api"`)
+	response.Error(c, http.StatusUnauthorized, models.ErrCodeUnauthenticated, message)
+}
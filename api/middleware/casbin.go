@@ -0,0 +1,46 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/muhriddinsalohiddin/online_store_api/api/handlers/models"
+	"github.com/muhriddinsalohiddin/online_store_api/api/response"
+	"github.com/muhriddinsalohiddin/online_store_api/pkg/logger"
+	"github.com/muhriddinsalohiddin/online_store_api/pkg/policy"
+)
+
+// AnonymousSubject is the policy subject of requests without a token
+const AnonymousSubject = "unauthorized"
+
+// Authorize enforces the casbin policy on (role, path, method). It must run
+// after Authenticate. Denied anonymous callers get 401 so they know to log in,
+// denied authenticated callers get 403.
+func Authorize(enforcer *policy.Enforcer, log logger.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		subject := AnonymousSubject
+		claims, authenticated := GetClaims(c)
+		if authenticated && claims.Role != "" {
+			subject = claims.Role
+		}
+
+		allowed, err := enforcer.Enforce(subject, c.Request.URL.Path, c.Request.Method)
+		if err != nil {
+			GetLogger(c, log).Error("failed to enforce policy", logger.Error(err))
+			response.Error(c, http.StatusInternalServerError, models.ErrCodeInternal, http.StatusText(http.StatusInternalServerError))
+			return
+		}
+
+		if !allowed {
+			if !authenticated {
+				Unauthorized(c, "authentication required")
+				return
+			}
+			response.Error(c, http.StatusForbidden, models.ErrCodePermissionDenied, "permission denied")
+			return
+		}
+
+		c.Next()
+	}
+}
@@ -0,0 +1,196 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/muhriddinsalohiddin/online_store_api/api/handlers/models"
+	"github.com/muhriddinsalohiddin/online_store_api/api/response"
+	"github.com/muhriddinsalohiddin/online_store_api/config"
+	"github.com/muhriddinsalohiddin/online_store_api/pkg/idempotency"
+	"github.com/muhriddinsalohiddin/online_store_api/pkg/logger"
+)
+
+const (
+	// IdempotencyKeyHeader carries the client chosen key of a retryable request
+	IdempotencyKeyHeader = "Idempotency-Key"
+	// IdempotentReplayedHeader marks a response replayed from the store
+	IdempotentReplayedHeader = "Idempotent-Replayed"
+
+	maxIdempotencyKey = 255
+	// maxIdempotentBody bounds the request body read for the fingerprint
+	maxIdempotentBody = 1 << 20
+	// idempotencyStoreTimeout bounds saving the response, which must
+	// happen even when the client already went away
+	idempotencyStoreTimeout = 2 * time.Second
+)
+
+// replayedHeaders are the response headers saved along with the body
+var replayedHeaders = []string{"Content-Type", "Location"}
+
+// Idempotency makes a request carrying an Idempotency-Key header safe to
+// retry. The first request with a key is served and its response saved for
+// cfg.IdempotencyTTL, retries with the same body get that response replayed,
+// a reuse of the key with a different body or while the first request is
+// still running gets 409. The key of a running request is only held for the
+// route timeout, so a request that never saves its response doesn't lock the
+// key for the whole TTL. Keys are scoped to the caller and the route. It must
+// run after Authenticate.
+func Idempotency(store idempotency.Store, cfg config.Config, log logger.Logger) gin.HandlerFunc {
+	ttl := time.Second * time.Duration(cfg.IdempotencyTTL)
+
+	return func(c *gin.Context) {
+		key := c.GetHeader(IdempotencyKeyHeader)
+		if key == "" {
+			c.Next()
+			return
+		}
+		if len(key) > maxIdempotencyKey {
+			response.Error(c, http.StatusBadRequest, models.ErrCodeInvalidArgument, "Invalid `Idempotency-Key` header, must be at most 255 characters long")
+			return
+		}
+
+		body, err := ioutil.ReadAll(http.MaxBytesReader(c.Writer, c.Request.Body, maxIdempotentBody))
+		if err != nil {
+			// MaxBytesReader fails once the limit is read
+			if len(body) >= maxIdempotentBody {
+				response.Error(c, http.StatusRequestEntityTooLarge, models.ErrCodePayloadTooLarge, "request body must be at most 1 MiB")
+				return
+			}
+			response.Error(c, http.StatusBadRequest, models.ErrCodeInvalidArgument, "failed to read request body")
+			return
+		}
+		c.Request.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+		ctx := c.Request.Context()
+		storeKey := idempotencyScope(c) + key
+		fingerprint := requestFingerprint(c, body)
+
+		// the key is held past the route timeout by the time saving the
+		// response may take
+		lockTTL := cfg.RouteTimeout(c.Request.Method+" "+c.FullPath()) + idempotencyStoreTimeout
+		existing, err := store.Reserve(ctx, storeKey, fingerprint, lockTTL)
+		if err != nil {
+			GetLogger(c, log).Error("failed to reserve idempotency key", logger.Error(err))
+			response.Error(c, http.StatusServiceUnavailable, models.ErrCodeUnavailable, "idempotency store unavailable")
+			return
+		}
+		if existing != nil {
+			replay(c, existing, fingerprint)
+			return
+		}
+
+		writer := &capturingWriter{ResponseWriter: c.Writer}
+		c.Writer = writer
+
+		saved := false
+		defer func() {
+			// a failed or panicking request gives the key back so the
+			// client can retry it
+			if saved {
+				return
+			}
+			storeCtx, cancel := context.WithTimeout(context.Background(), idempotencyStoreTimeout)
+			defer cancel()
+			if err := store.Release(storeCtx, storeKey); err != nil {
+				GetLogger(c, log).Error("failed to release idempotency key", logger.Error(err))
+			}
+		}()
+
+		c.Next()
+
+		status := writer.Status()
+		if !replayable(status) {
+			return
+		}
+
+		record := &idempotency.Record{
+			Fingerprint: fingerprint,
+			Status:      status,
+			Header:      make(http.Header, len(replayedHeaders)),
+			Body:        writer.body.Bytes(),
+		}
+		for _, name := range replayedHeaders {
+			if v := writer.Header().Get(name); v != "" {
+				record.Header.Set(name, v)
+			}
+		}
+
+		storeCtx, cancel := context.WithTimeout(context.Background(), idempotencyStoreTimeout)
+		defer cancel()
+		if err := store.Complete(storeCtx, storeKey, record, ttl); err != nil {
+			GetLogger(c, log).Error("failed to save idempotent response", logger.Error(err))
+			return
+		}
+		saved = true
+	}
+}
+
+func replay(c *gin.Context, record *idempotency.Record, fingerprint string) {
+	if record.Fingerprint != fingerprint {
+		response.Error(c, http.StatusConflict, models.ErrCodeConflict, "Idempotency-Key was already used with a different request")
+		return
+	}
+	if !record.Completed() {
+		response.Error(c, http.StatusConflict, models.ErrCodeConflict, "a request with this Idempotency-Key is still in progress")
+		return
+	}
+
+	for name, values := range record.Header {
+		for _, v := range values {
+			c.Writer.Header().Add(name, v)
+		}
+	}
+	c.Header(IdempotentReplayedHeader, "true")
+	c.Writer.WriteHeader(record.Status)
+	_, _ = c.Writer.Write(record.Body)
+	c.Abort()
+}
+
+// replayable reports whether a response is final, server errors and rate
+// limiting are worth retrying and aren't saved
+func replayable(status int) bool {
+	return status < http.StatusInternalServerError &&
+		status != http.StatusTooManyRequests &&
+		status != response.StatusClientClosedRequest
+}
+
+func idempotencyScope(c *gin.Context) string {
+	subject := AnonymousSubject
+	if claims, ok := GetClaims(c); ok {
+		subject = claims.UserID()
+	}
+
+	return subject + "|" + c.Request.Method + " " + c.Request.URL.Path + "|"
+}
+
+func requestFingerprint(c *gin.Context, body []byte) string {
+	h := sha256.New()
+	h.Write([]byte(c.Request.Method + " " + c.Request.URL.RequestURI() + "\n"))
+	h.Write(body)
+
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// capturingWriter keeps a copy of the response body
+type capturingWriter struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+
+func (w *capturingWriter) Write(b []byte) (int, error) {
+	w.body.Write(b)
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *capturingWriter) WriteString(s string) (int, error) {
+	w.body.WriteString(s)
+	return w.ResponseWriter.WriteString(s)
+}
@@ -0,0 +1,195 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/muhriddinsalohiddin/online_store_api/config"
+	"github.com/muhriddinsalohiddin/online_store_api/pkg/idempotency"
+	"github.com/muhriddinsalohiddin/online_store_api/pkg/logger"
+)
+
+// idempotencyConfig keeps responses for a minute and running requests for
+// the 5 second route timeout
+var idempotencyConfig = config.Config{IdempotencyTTL: 60, CtxTimeout: 5}
+
+// idempotentRouter serves POST /orders behind Idempotency, the handler
+// answers status and counts its calls
+func idempotentRouter(status int, calls *int) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.POST("/orders", Idempotency(idempotency.NewMemory(), idempotencyConfig, logger.New("error", "test")), func(c *gin.Context) {
+		*calls++
+		c.JSON(status, gin.H{"id": *calls})
+	})
+
+	return router
+}
+
+func postOrder(router *gin.Engine, key, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/orders", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	if key != "" {
+		req.Header.Set(IdempotencyKeyHeader, key)
+	}
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	return w
+}
+
+func TestIdempotencyReplay(t *testing.T) {
+	calls := 0
+	router := idempotentRouter(http.StatusCreated, &calls)
+
+	first := postOrder(router, "key-1", `{"book_id":"1"}`)
+	retry := postOrder(router, "key-1", `{"book_id":"1"}`)
+
+	if calls != 1 {
+		t.Fatalf("handler called %d times, want 1", calls)
+	}
+	if first.Header().Get(IdempotentReplayedHeader) != "" {
+		t.Errorf("first response marked as replayed")
+	}
+	if retry.Header().Get(IdempotentReplayedHeader) != "true" {
+		t.Errorf("%s = %q, want true", IdempotentReplayedHeader, retry.Header().Get(IdempotentReplayedHeader))
+	}
+	if retry.Code != first.Code || retry.Body.String() != first.Body.String() {
+		t.Errorf("replayed %d %s, want %d %s", retry.Code, retry.Body, first.Code, first.Body)
+	}
+	if retry.Header().Get("Content-Type") != first.Header().Get("Content-Type") {
+		t.Errorf("replayed Content-Type %q, want %q", retry.Header().Get("Content-Type"), first.Header().Get("Content-Type"))
+	}
+}
+
+func TestIdempotency(t *testing.T) {
+	tests := []struct {
+		name      string
+		status    int
+		requests  [][2]string // key and body of each request
+		wantCalls int
+		wantLast  int
+	}{
+		{
+			name:      "no key is never replayed",
+			status:    http.StatusCreated,
+			requests:  [][2]string{{"", `{}`}, {"", `{}`}},
+			wantCalls: 2,
+			wantLast:  http.StatusCreated,
+		},
+		{
+			name:      "key reused with another body",
+			status:    http.StatusCreated,
+			requests:  [][2]string{{"key-1", `{"book_id":"1"}`}, {"key-1", `{"book_id":"2"}`}},
+			wantCalls: 1,
+			wantLast:  http.StatusConflict,
+		},
+		{
+			name:      "server errors aren't saved",
+			status:    http.StatusServiceUnavailable,
+			requests:  [][2]string{{"key-1", `{}`}, {"key-1", `{}`}},
+			wantCalls: 2,
+			wantLast:  http.StatusServiceUnavailable,
+		},
+		{
+			name:      "client errors are saved",
+			status:    http.StatusUnprocessableEntity,
+			requests:  [][2]string{{"key-1", `{}`}, {"key-1", `{}`}},
+			wantCalls: 1,
+			wantLast:  http.StatusUnprocessableEntity,
+		},
+		{
+			name:      "body too large",
+			status:    http.StatusCreated,
+			requests:  [][2]string{{"key-1", `{"description":"` + strings.Repeat("x", maxIdempotentBody) + `"}`}},
+			wantCalls: 0,
+			wantLast:  http.StatusRequestEntityTooLarge,
+		},
+		{
+			name:      "key too long",
+			status:    http.StatusCreated,
+			requests:  [][2]string{{strings.Repeat("k", maxIdempotencyKey+1), `{}`}},
+			wantCalls: 0,
+			wantLast:  http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			router := idempotentRouter(tt.status, &calls)
+
+			var last *httptest.ResponseRecorder
+			for _, r := range tt.requests {
+				last = postOrder(router, r[0], r[1])
+			}
+
+			if calls != tt.wantCalls {
+				t.Errorf("handler called %d times, want %d", calls, tt.wantCalls)
+			}
+			if last.Code != tt.wantLast {
+				t.Errorf("last status = %d, want %d", last.Code, tt.wantLast)
+			}
+		})
+	}
+}
+
+func TestIdempotencyInFlight(t *testing.T) {
+	store := idempotency.NewMemory()
+	router := gin.New()
+	router.POST("/orders", Idempotency(store, idempotencyConfig, logger.New("error", "test")), func(c *gin.Context) {
+		c.Status(http.StatusCreated)
+	})
+
+	// a request holding the key that hasn't finished yet
+	req := httptest.NewRequest(http.MethodPost, "/orders", strings.NewReader(`{}`))
+	key := AnonymousSubject + "|POST /orders|key-1"
+	fingerprint := requestFingerprint(&gin.Context{Request: req}, []byte(`{}`))
+	if _, err := store.Reserve(req.Context(), key, fingerprint, time.Minute); err != nil {
+		t.Fatalf("Reserve: %v", err)
+	}
+
+	w := postOrder(router, "key-1", `{}`)
+	if w.Code != http.StatusConflict || !strings.Contains(w.Body.String(), "still in progress") {
+		t.Fatalf("got %d %s, want %d for a request in progress", w.Code, w.Body, http.StatusConflict)
+	}
+}
+
+// lockStore records the TTLs the middleware asks for
+type lockStore struct {
+	idempotency.Store
+	reserveTTL, completeTTL time.Duration
+}
+
+func (s *lockStore) Reserve(ctx context.Context, key, fingerprint string, ttl time.Duration) (*idempotency.Record, error) {
+	s.reserveTTL = ttl
+	return s.Store.Reserve(ctx, key, fingerprint, ttl)
+}
+
+func (s *lockStore) Complete(ctx context.Context, key string, record *idempotency.Record, ttl time.Duration) error {
+	s.completeTTL = ttl
+	return s.Store.Complete(ctx, key, record, ttl)
+}
+
+func TestIdempotencyLockTTL(t *testing.T) {
+	store := &lockStore{Store: idempotency.NewMemory()}
+	router := gin.New()
+	router.POST("/orders", Idempotency(store, idempotencyConfig, logger.New("error", "test")), func(c *gin.Context) {
+		c.Status(http.StatusCreated)
+	})
+
+	postOrder(router, "key-1", `{}`)
+
+	if want := 5*time.Second + idempotencyStoreTimeout; store.reserveTTL != want {
+		t.Errorf("running request held for %s, want the route timeout %s", store.reserveTTL, want)
+	}
+	if store.completeTTL != time.Minute {
+		t.Errorf("response kept for %s, want %s", store.completeTTL, time.Minute)
+	}
+}
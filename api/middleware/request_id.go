@@ -0,0 +1,29 @@
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+const (
+	// RequestIDHeader ...
+	RequestIDHeader = "X-Request-ID"
+	// RequestIDKey is the gin context key the request id is stored under
+	RequestIDKey = "request_id"
+)
+
+// RequestID reuses the incoming X-Request-ID header or generates a new one,
+// stores it in the gin context and echoes it back in the response headers
+func RequestID() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		requestID := c.GetHeader(RequestIDHeader)
+		if requestID == "" || len(requestID) > 128 {
+			requestID = uuid.NewString()
+		}
+
+		c.Set(RequestIDKey, requestID)
+		c.Header(RequestIDHeader, requestID)
+
+		c.Next()
+	}
+}
@@ -0,0 +1,34 @@
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/muhriddinsalohiddin/online_store_api/pkg/logger"
+)
+
+// LoggerKey is the gin context key the request scoped logger is stored under
+const LoggerKey = "logger"
+
+// RequestLogger stores a logger annotated with the request id and the trace
+// and span ids in the gin context, it runs after RequestID and the tracing
+// middleware so every later log line of the request carries them
+func RequestLogger(log logger.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		l := logger.WithFields(log, logger.String("request_id", c.GetString(RequestIDKey)))
+		c.Set(LoggerKey, logger.WithTrace(c.Request.Context(), l))
+
+		c.Next()
+	}
+}
+
+// GetLogger returns the request scoped logger stored by RequestLogger, or
+// fallback when the request didn't go through it
+func GetLogger(c *gin.Context, fallback logger.Logger) logger.Logger {
+	if l, ok := c.Get(LoggerKey); ok {
+		if log, ok := l.(logger.Logger); ok {
+			return log
+		}
+	}
+
+	return fallback
+}
@@ -0,0 +1,110 @@
+package response
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/muhriddinsalohiddin/online_store_api/api/handlers/models"
+)
+
+const requestIDHeader = "X-Request-ID"
+
+// StatusClientClosedRequest is returned when the client went away before
+// the upstream call finished
+const StatusClientClosedRequest = 499
+
+type grpcMapping struct {
+	httpStatus int
+	code       string
+}
+
+var grpcToHTTP = map[codes.Code]grpcMapping{
+	codes.InvalidArgument:    {http.StatusBadRequest, models.ErrCodeInvalidArgument},
+	codes.OutOfRange:         {http.StatusBadRequest, models.ErrCodeInvalidArgument},
+	codes.NotFound:           {http.StatusNotFound, models.ErrCodeNotFound},
+	codes.AlreadyExists:      {http.StatusConflict, models.ErrCodeAlreadyExists},
+	codes.Aborted:            {http.StatusConflict, models.ErrCodeConflict},
+	codes.FailedPrecondition: {http.StatusPreconditionFailed, models.ErrCodePreconditionFailed},
+	codes.Unauthenticated:    {http.StatusUnauthorized, models.ErrCodeUnauthenticated},
+	codes.PermissionDenied:   {http.StatusForbidden, models.ErrCodePermissionDenied},
+	codes.ResourceExhausted:  {http.StatusTooManyRequests, models.ErrCodeResourceExhausted},
+	codes.Canceled:           {StatusClientClosedRequest, models.ErrCodeCanceled},
+	codes.Unimplemented:      {http.StatusNotImplemented, models.ErrCodeUnimplemented},
+	codes.Unavailable:        {http.StatusServiceUnavailable, models.ErrCodeUnavailable},
+	codes.DeadlineExceeded:   {http.StatusGatewayTimeout, models.ErrCodeDeadlineExceeded},
+}
+
+// HTTPStatusFromCode maps a gRPC status code to an HTTP status and a machine readable error code
+func HTTPStatusFromCode(code codes.Code) (int, string) {
+	if m, ok := grpcToHTTP[code]; ok {
+		return m.httpStatus, m.code
+	}
+
+	return http.StatusInternalServerError, models.ErrCodeInternal
+}
+
+// Error aborts the request with the given status and writes the StandardErrorModel envelope
+func Error(c *gin.Context, httpStatus int, code, message string, details ...models.ErrorDetail) {
+	c.AbortWithStatusJSON(httpStatus, models.StandardErrorModel{
+		Error: models.Error{
+			Code:      code,
+			Message:   message,
+			RequestID: c.Writer.Header().Get(requestIDHeader),
+			Details:   details,
+		},
+	})
+}
+
+// GRPCError translates an error returned by a gRPC client into an HTTP response.
+// It returns the HTTP status that was written.
+func GRPCError(c *gin.Context, err error) int {
+	st, ok := status.FromError(err)
+	if !ok {
+		Error(c, http.StatusInternalServerError, models.ErrCodeInternal, http.StatusText(http.StatusInternalServerError))
+		return http.StatusInternalServerError
+	}
+
+	httpStatus, code := HTTPStatusFromCode(st.Code())
+	message := st.Message()
+	if httpStatus >= http.StatusInternalServerError && httpStatus != http.StatusNotImplemented {
+		// don't leak backend internals to the client
+		message = http.StatusText(httpStatus)
+	}
+
+	var details []models.ErrorDetail
+	for _, d := range st.Details() {
+		switch info := d.(type) {
+		case *errdetails.BadRequest:
+			for _, v := range info.GetFieldViolations() {
+				details = append(details, models.ErrorDetail{
+					Field:       v.GetField(),
+					Description: v.GetDescription(),
+				})
+			}
+		case *errdetails.PreconditionFailure:
+			for _, v := range info.GetViolations() {
+				details = append(details, models.ErrorDetail{
+					Field:       v.GetSubject(),
+					Description: v.GetDescription(),
+				})
+			}
+		case *errdetails.ErrorInfo:
+			if info.GetReason() != "" {
+				code = info.GetReason()
+			}
+		case *errdetails.RetryInfo:
+			if delay := info.GetRetryDelay(); delay != nil && delay.GetSeconds() > 0 {
+				c.Header("Retry-After", strconv.FormatInt(delay.GetSeconds(), 10))
+			}
+		}
+	}
+
+	Error(c, httpStatus, code, message, details...)
+
+	return httpStatus
+}
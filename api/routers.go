@@ -2,28 +2,45 @@ package api
 
 import (
 	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	swaggerFiles "github.com/swaggo/files"
 	ginSwagger "github.com/swaggo/gin-swagger"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/gin-gonic/gin/otelgin"
 
 	_ "github.com/muhriddinsalohiddin/online_store_api/api/docs" // swag
 	v1 "github.com/muhriddinsalohiddin/online_store_api/api/handlers/v1"
+	"github.com/muhriddinsalohiddin/online_store_api/api/middleware"
 	"github.com/muhriddinsalohiddin/online_store_api/config"
+	"github.com/muhriddinsalohiddin/online_store_api/pkg/auth"
+	"github.com/muhriddinsalohiddin/online_store_api/pkg/idempotency"
 	"github.com/muhriddinsalohiddin/online_store_api/pkg/logger"
+	"github.com/muhriddinsalohiddin/online_store_api/pkg/policy"
 	"github.com/muhriddinsalohiddin/online_store_api/services"
 )
 
 // Option ...
 type Option struct {
 	Conf           config.Config
+	ServiceName    string
 	Logger         logger.Logger
 	ServiceManager services.IServiceManager
+	KeySet         *auth.KeySet
+	Enforcer       *policy.Enforcer
+	Idempotency    idempotency.Store
 }
 
 // New ...
+// @securityDefinitions.apikey BearerAuth
+// @in header
+// @name Authorization
 func New(option Option) *gin.Engine {
 	router := gin.New()
 
-	router.Use(gin.Logger())
+	router.Use(middleware.RequestID())
+	router.Use(otelgin.Middleware(option.ServiceName))
+	router.Use(middleware.RequestLogger(option.Logger))
+	router.Use(middleware.Metrics())
+	router.Use(middleware.AccessLog(option.Logger, option.Conf))
 	router.Use(gin.Recovery())
 
 	handlerV1 := v1.New(&v1.HandlerV1Config{
@@ -32,31 +49,68 @@ func New(option Option) *gin.Engine {
 		Cfg:            option.Conf,
 	})
 
+	router.GET("/healthz", handlerV1.Liveness)
+	router.GET("/readyz", handlerV1.Readiness)
+	router.GET("/metrics", gin.WrapH(promhttp.Handler()))
+
 	api := router.Group("/v1")
+	api.Use(middleware.Authenticate(option.KeySet))
+	api.Use(middleware.Authorize(option.Enforcer, option.Logger))
+
+	catalogWrite := middleware.RequirePermission(auth.PermCatalogWrite)
+	orderRead := middleware.RequirePermission(auth.PermOrderRead)
+	orderWrite := middleware.RequirePermission(auth.PermOrderWrite)
+	orderManage := middleware.RequirePermission(auth.PermOrderManage)
+	purge := middleware.RequirePermission(auth.PermPurge)
+	idempotent := middleware.Idempotency(option.Idempotency, option.Conf, option.Logger)
+
 	// Books
-	api.POST("/books", handlerV1.CreateBook)
+	api.POST("/books", catalogWrite, idempotent, handlerV1.CreateBook)
 	api.GET("/books/:id", handlerV1.GetBookById)
-	api.PUT("/books/:id", handlerV1.UpdateBook)
-	api.DELETE("books/:id", handlerV1.DeleteBook)
+	api.PUT("/books/:id", catalogWrite, handlerV1.UpdateBook)
+	api.PATCH("/books/:id", catalogWrite, handlerV1.PatchBook)
+	api.DELETE("books/:id", catalogWrite, handlerV1.DeleteBook)
+	api.POST("/books/:id/restore", catalogWrite, handlerV1.RestoreBook)
+	api.DELETE("/books/:id/purge", purge, handlerV1.PurgeBook)
 	api.GET("/books", handlerV1.ListBooks)
 	// Categories
-	api.POST("/categories", handlerV1.CreateCategory)
+	api.POST("/categories", catalogWrite, idempotent, handlerV1.CreateCategory)
+	api.GET("/categories/tree", handlerV1.GetCategoryTree)
 	api.GET("/categories/:id", handlerV1.GetCategoryById)
-	api.PUT("/categories/:id", handlerV1.UpdateCategory)
-	api.DELETE("categories/:id", handlerV1.DeleteCategoryById)
+	api.GET("/categories/:id/children", handlerV1.ListCategoryChildren)
+	api.GET("/categories/:id/ancestors", handlerV1.ListCategoryAncestors)
+	api.GET("/categories/:id/books", handlerV1.ListCategoryBooks)
+	api.PUT("/categories/:id", catalogWrite, handlerV1.UpdateCategory)
+	api.PATCH("/categories/:id", catalogWrite, handlerV1.PatchCategory)
+	api.DELETE("categories/:id", catalogWrite, handlerV1.DeleteCategoryById)
+	api.POST("/categories/:id/restore", catalogWrite, handlerV1.RestoreCategory)
+	api.DELETE("/categories/:id/purge", purge, handlerV1.PurgeCategory)
 	api.GET("/categories", handlerV1.ListCategories)
 	// Authors
-	api.POST("/authors", handlerV1.CreateAuthor)
+	api.POST("/authors", catalogWrite, idempotent, handlerV1.CreateAuthor)
 	api.GET("/authors/:id", handlerV1.GetAuthor)
-	api.PUT("/authors/:id", handlerV1.UpdateAuthor)
-	api.DELETE("authors/:id", handlerV1.DeleteAuthor)
+	api.GET("/authors/:id/books", handlerV1.ListAuthorBooks)
+	api.PUT("/authors/:id", catalogWrite, handlerV1.UpdateAuthor)
+	api.PATCH("/authors/:id", catalogWrite, handlerV1.PatchAuthor)
+	api.DELETE("authors/:id", catalogWrite, handlerV1.DeleteAuthor)
+	api.POST("/authors/:id/restore", catalogWrite, handlerV1.RestoreAuthor)
+	api.DELETE("/authors/:id/purge", purge, handlerV1.PurgeAuthor)
 	api.GET("/authors", handlerV1.ListAuthors)
 	// Orders
-	api.POST("/orders", handlerV1.CreateOrder)
-	api.GET("/orders/:id", handlerV1.GetOrderById)
-	api.PUT("/orders/:id", handlerV1.UpdateOrder)
-	api.DELETE("orders/:id", handlerV1.DeleteOrder)
-	api.GET("/orders", handlerV1.ListOrders)
+	api.POST("/orders", orderWrite, idempotent, handlerV1.CreateOrder)
+	api.GET("/orders/:id", orderRead, handlerV1.GetOrderById)
+	api.PUT("/orders/:id", orderWrite, handlerV1.UpdateOrder)
+	api.PATCH("/orders/:id", orderWrite, handlerV1.PatchOrder)
+	api.DELETE("orders/:id", orderWrite, handlerV1.DeleteOrder)
+	api.POST("/orders/:id/restore", orderManage, handlerV1.RestoreOrder)
+	api.DELETE("/orders/:id/purge", purge, handlerV1.PurgeOrder)
+	api.GET("/orders", orderRead, handlerV1.ListOrders)
+	api.GET("/orders/:id/history", orderRead, handlerV1.GetOrderStatusHistory)
+	api.POST("/orders/:id/pay", orderWrite, handlerV1.PayOrder)
+	api.POST("/orders/:id/ship", orderWrite, handlerV1.ShipOrder)
+	api.POST("/orders/:id/deliver", orderWrite, handlerV1.DeliverOrder)
+	api.POST("/orders/:id/cancel", orderWrite, handlerV1.CancelOrder)
+	api.POST("/orders/:id/refund", orderWrite, handlerV1.RefundOrder)
 
 	url := ginSwagger.URL("swagger/doc.json") // The url pointing to API definition
 	router.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler, url))
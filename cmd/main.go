@@ -1,29 +1,104 @@
 package main
 
 import (
+	"context"
+	"errors"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
 	"github.com/muhriddinsalohiddin/online_store_api/api"
 	"github.com/muhriddinsalohiddin/online_store_api/config"
+	"github.com/muhriddinsalohiddin/online_store_api/pkg/auth"
+	"github.com/muhriddinsalohiddin/online_store_api/pkg/idempotency"
 	"github.com/muhriddinsalohiddin/online_store_api/pkg/logger"
+	"github.com/muhriddinsalohiddin/online_store_api/pkg/policy"
+	"github.com/muhriddinsalohiddin/online_store_api/pkg/tracing"
 	"github.com/muhriddinsalohiddin/online_store_api/services"
 )
 
+const serviceName = "api_gateway"
+
 func main() {
 	cfg := config.Load()
-	log := logger.New(cfg.LogLevel, "api_gateway")
+	log := logger.New(cfg.LogLevel, serviceName)
+	defer logger.Cleanup(log)
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	shutdownTracing, err := tracing.Init(ctx, &cfg, serviceName)
+	if err != nil {
+		log.Fatal("failed to init tracing", logger.Error(err))
+	}
 
 	serviceManager, err := services.NewServiceManager(&cfg)
 	if err != nil {
-		log.Error("gRPC dial error", logger.Error(err))
+		log.Fatal("gRPC dial error", logger.Error(err))
+	}
+
+	keySet, err := auth.LoadKeySet(&cfg)
+	if err != nil {
+		log.Fatal("failed to load jwt keys", logger.Error(err))
+	}
+
+	enforcer, err := policy.NewEnforcer(&cfg, log)
+	if err != nil {
+		log.Fatal("failed to load casbin policy", logger.Error(err))
+	}
+	go enforcer.Watch(ctx, time.Second*time.Duration(cfg.CasbinReloadInterval))
+
+	idempotencyStore, err := idempotency.New(&cfg)
+	if err != nil {
+		log.Fatal("failed to init idempotency store", logger.Error(err))
+	}
+
+	server := &http.Server{
+		Addr: cfg.HTTPPort,
+		Handler: api.New(api.Option{
+			Conf:           cfg,
+			ServiceName:    serviceName,
+			Logger:         log,
+			ServiceManager: serviceManager,
+			KeySet:         keySet,
+			Enforcer:       enforcer,
+			Idempotency:    idempotencyStore,
+		}),
 	}
 
-	server := api.New(api.Option{
-		Conf:           cfg,
-		Logger:         log,
-		ServiceManager: serviceManager,
-	})
+	go func() {
+		if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Fatal("failed to run http server", logger.Error(err))
+		}
+	}()
+	log.Info("http server started", logger.String("port", cfg.HTTPPort))
 
-	if err := server.Run(cfg.HTTPPort); err != nil {
-		log.Fatal("failed to run http server", logger.Error(err))
-		panic(err)
+	<-ctx.Done()
+	stop()
+	log.Info("shutting down, draining in-flight requests", logger.Int("drain_timeout", cfg.DrainTimeout))
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(cfg.DrainTimeout))
+	defer cancel()
+
+	// stop accepting connections and wait for in-flight requests before the
+	// gRPC connections they are using get closed
+	if err := server.Shutdown(shutdownCtx); err != nil {
+		log.Error("http server shutdown did not complete", logger.Error(err))
 	}
+
+	if err := serviceManager.Close(); err != nil {
+		log.Error("failed to close gRPC connections", logger.Error(err))
+	}
+
+	if err := idempotencyStore.Close(); err != nil {
+		log.Error("failed to close idempotency store", logger.Error(err))
+	}
+
+	if err := shutdownTracing(shutdownCtx); err != nil {
+		log.Error("failed to flush traces", logger.Error(err))
+	}
+
+	log.Info("server stopped")
 }
@@ -2,6 +2,8 @@ package config
 
 import (
 	"os"
+	"strings"
+	"time"
 
 	"github.com/spf13/cast"
 )
@@ -18,9 +20,80 @@ type Config struct {
 
 	// context timeout in seconds
 	CtxTimeout int
+	// per route overrides of CtxTimeout keyed by "METHOD /route/:template",
+	// loaded from ROUTE_TIMEOUTS="POST /v1/orders=15,GET /v1/books=3"
+	RouteTimeouts map[string]int
+
+	// retries of idempotent gRPC calls, backoff in milliseconds
+	GRPCRetryMax        int
+	GRPCRetryBackoff    int
+	GRPCRetryBackoffMax int
+
+	// consecutive failures that open a backend circuit breaker and
+	// seconds it stays open before a trial request is let through
+	CircuitBreakerFailures int
+	CircuitBreakerTimeout  int
 
 	LogLevel string
 	HTTPPort string
+
+	// share of successful requests written to the access log, failed ones are
+	// always logged. Request bodies are logged only when AccessLogBody is set,
+	// the listed headers and JSON body fields are redacted.
+	AccessLogSampleRate    float64
+	AccessLogBody          bool
+	AccessLogRedactHeaders []string
+	AccessLogRedactFields  []string
+
+	// seconds in-flight requests get to finish on shutdown
+	DrainTimeout int
+
+	// tracing exporter: otlp, stdout or none
+	TracingExporter    string
+	TracingSampleRatio float64
+	OTLPEndpoint       string
+
+	// JWT authentication. JWTSigningKey is an HS256 secret used when
+	// JWTKeySetFile (JSON set of HS256/RS256 keys selected by kid) is empty
+	JWTSigningKey string
+	JWTKeySetFile string
+	JWTIssuer     string
+	JWTAudience   string
+
+	// casbin model and policy files, the policy is reloaded
+	// every CasbinReloadInterval seconds when it changes on disk
+	CasbinModelPath      string
+	CasbinPolicyPath     string
+	CasbinReloadInterval int
+
+	// list endpoints reject limits above MaxPageLimit. Pagination cursors are
+	// signed with CursorSigningKey, a random key is used when it's empty, and
+	// expire after CursorTTL seconds, 0 disables expiry
+	MaxPageLimit     int
+	CursorSigningKey string
+	CursorTTL        int
+
+	// cache in front of the catalog single entity reads: memory, redis or
+	// none. CacheSize bounds the in-memory entries, TTLs are in seconds and
+	// 0 disables caching of that entity
+	CacheBackend     string
+	CacheSize        int
+	CacheTTLBook     int
+	CacheTTLAuthor   int
+	CacheTTLCategory int
+	RedisAddr        string
+	RedisPassword    string
+	RedisDB          int
+
+	// Idempotency-Key records of the create endpoints: memory or redis, the
+	// redis backend shares the server settings above. Saved responses expire
+	// after IdempotencyTTL seconds, a key of an unfinished request is held for
+	// the route timeout only
+	IdempotencyBackend string
+	IdempotencyTTL     int
+
+	// RequireIfMatch makes PUT, PATCH and DELETE answer 428 without an If-Match header
+	RequireIfMatch bool
 }
 
 // Load loads environment vars and inflates Config
@@ -31,6 +104,16 @@ func Load() Config {
 
 	c.LogLevel = cast.ToString(getOrReturnDefault("LOG_LEVEL", "debug"))
 	c.HTTPPort = cast.ToString(getOrReturnDefault("HTTP_PORT", ":8080"))
+
+	c.AccessLogSampleRate = cast.ToFloat64(getOrReturnDefault("ACCESS_LOG_SAMPLE_RATE", 1))
+	c.AccessLogBody = cast.ToBool(getOrReturnDefault("ACCESS_LOG_BODY", false))
+	c.AccessLogRedactHeaders = splitList(cast.ToString(getOrReturnDefault("ACCESS_LOG_REDACT_HEADERS", "Authorization,Cookie,Set-Cookie,X-Api-Key")))
+	c.AccessLogRedactFields = splitList(cast.ToString(getOrReturnDefault("ACCESS_LOG_REDACT_FIELDS", "password,token,access_token,refresh_token,secret")))
+	c.DrainTimeout = cast.ToInt(getOrReturnDefault("DRAIN_TIMEOUT", 15))
+
+	c.TracingExporter = cast.ToString(getOrReturnDefault("TRACING_EXPORTER", "none"))
+	c.TracingSampleRatio = cast.ToFloat64(getOrReturnDefault("TRACING_SAMPLE_RATIO", 1))
+	c.OTLPEndpoint = cast.ToString(getOrReturnDefault("OTLP_ENDPOINT", "localhost:4317"))
 	c.CatalogServiceHost = cast.ToString(getOrReturnDefault("CatalogService_HOST", "localhost"))
 	c.CatalogServicePort = cast.ToInt(getOrReturnDefault("CatalogService_PORT", 9005))
 
@@ -38,6 +121,41 @@ func Load() Config {
 	c.OrderServicePort = cast.ToInt(getOrReturnDefault("OrderService_PORT", 9006))
 
 	c.CtxTimeout = cast.ToInt(getOrReturnDefault("CTX_TIMEOUT", 7))
+	c.RouteTimeouts = parseRouteTimeouts(cast.ToString(getOrReturnDefault("ROUTE_TIMEOUTS", "")))
+
+	c.GRPCRetryMax = cast.ToInt(getOrReturnDefault("GRPC_RETRY_MAX", 3))
+	c.GRPCRetryBackoff = cast.ToInt(getOrReturnDefault("GRPC_RETRY_BACKOFF", 100))
+	c.GRPCRetryBackoffMax = cast.ToInt(getOrReturnDefault("GRPC_RETRY_BACKOFF_MAX", 2000))
+
+	c.CircuitBreakerFailures = cast.ToInt(getOrReturnDefault("CIRCUIT_BREAKER_FAILURES", 5))
+	c.CircuitBreakerTimeout = cast.ToInt(getOrReturnDefault("CIRCUIT_BREAKER_TIMEOUT", 30))
+
+	c.JWTSigningKey = cast.ToString(getOrReturnDefault("JWT_SIGNING_KEY", ""))
+	c.JWTKeySetFile = cast.ToString(getOrReturnDefault("JWT_KEY_SET_FILE", ""))
+	c.JWTIssuer = cast.ToString(getOrReturnDefault("JWT_ISSUER", ""))
+	c.JWTAudience = cast.ToString(getOrReturnDefault("JWT_AUDIENCE", ""))
+
+	c.CasbinModelPath = cast.ToString(getOrReturnDefault("CASBIN_MODEL_PATH", "./config/auth.conf"))
+	c.CasbinPolicyPath = cast.ToString(getOrReturnDefault("CASBIN_POLICY_PATH", "./config/auth.csv"))
+	c.CasbinReloadInterval = cast.ToInt(getOrReturnDefault("CASBIN_RELOAD_INTERVAL", 10))
+
+	c.MaxPageLimit = cast.ToInt(getOrReturnDefault("MAX_PAGE_LIMIT", 100))
+	c.CursorSigningKey = cast.ToString(getOrReturnDefault("CURSOR_SIGNING_KEY", ""))
+	c.CursorTTL = cast.ToInt(getOrReturnDefault("CURSOR_TTL", 86400))
+
+	c.CacheBackend = cast.ToString(getOrReturnDefault("CACHE_BACKEND", "memory"))
+	c.CacheSize = cast.ToInt(getOrReturnDefault("CACHE_SIZE", 10000))
+	c.CacheTTLBook = cast.ToInt(getOrReturnDefault("CACHE_TTL_BOOK", 60))
+	c.CacheTTLAuthor = cast.ToInt(getOrReturnDefault("CACHE_TTL_AUTHOR", 300))
+	c.CacheTTLCategory = cast.ToInt(getOrReturnDefault("CACHE_TTL_CATEGORY", 300))
+	c.RedisAddr = cast.ToString(getOrReturnDefault("REDIS_ADDR", "localhost:6379"))
+	c.RedisPassword = cast.ToString(getOrReturnDefault("REDIS_PASSWORD", ""))
+	c.RedisDB = cast.ToInt(getOrReturnDefault("REDIS_DB", 0))
+
+	c.IdempotencyBackend = cast.ToString(getOrReturnDefault("IDEMPOTENCY_BACKEND", "memory"))
+	c.IdempotencyTTL = cast.ToInt(getOrReturnDefault("IDEMPOTENCY_TTL", 86400))
+
+	c.RequireIfMatch = cast.ToBool(getOrReturnDefault("REQUIRE_IF_MATCH", false))
 
 	return c
 }
@@ -50,3 +168,46 @@ func getOrReturnDefault(key string, defaultValue interface{}) interface{} {
 
 	return defaultValue
 }
+
+// RouteTimeout is the context timeout of the route keyed "METHOD /route/:template"
+func (c Config) RouteTimeout(route string) time.Duration {
+	if seconds, ok := c.RouteTimeouts[route]; ok {
+		return time.Second * time.Duration(seconds)
+	}
+
+	return time.Second * time.Duration(c.CtxTimeout)
+}
+
+func parseRouteTimeouts(value string) map[string]int {
+	timeouts := make(map[string]int)
+
+	for _, pair := range strings.Split(value, ",") {
+		route, seconds := splitLast(strings.TrimSpace(pair), "=")
+		if route == "" || cast.ToInt(seconds) <= 0 {
+			continue
+		}
+		timeouts[route] = cast.ToInt(seconds)
+	}
+
+	return timeouts
+}
+
+func splitList(value string) []string {
+	var list []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			list = append(list, item)
+		}
+	}
+
+	return list
+}
+
+func splitLast(s, sep string) (string, string) {
+	i := strings.LastIndex(s, sep)
+	if i < 0 {
+		return "", ""
+	}
+
+	return strings.TrimSpace(s[:i]), strings.TrimSpace(s[i+len(sep):])
+}
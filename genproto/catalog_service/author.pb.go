@@ -5,6 +5,7 @@ package catalog
 
 import (
 	fmt "fmt"
+	types "github.com/gogo/protobuf/types"
 	proto "github.com/golang/protobuf/proto"
 	io "io"
 	math "math"
@@ -103,6 +104,7 @@ func (m *Author) GetDeletedAt() string {
 
 type GetAuthorByIdReq struct {
 	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	ExpectedUpdatedAt    string   `protobuf:"bytes,2,opt,name=expected_updated_at,json=expectedUpdatedAt,proto3" json:"expected_updated_at"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -148,9 +150,75 @@ func (m *GetAuthorByIdReq) GetId() string {
 	return ""
 }
 
+func (m *GetAuthorByIdReq) GetExpectedUpdatedAt() string {
+	if m != nil {
+		return m.ExpectedUpdatedAt
+	}
+	return ""
+}
+
+type PatchAuthorReq struct {
+	Author               *Author          `protobuf:"bytes,1,opt,name=author,proto3" json:"author"`
+	UpdateMask           *types.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *PatchAuthorReq) Reset()         { *m = PatchAuthorReq{} }
+func (m *PatchAuthorReq) String() string { return proto.CompactTextString(m) }
+func (*PatchAuthorReq) ProtoMessage()    {}
+func (*PatchAuthorReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd39da4a78905a51, []int{2}
+}
+func (m *PatchAuthorReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PatchAuthorReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PatchAuthorReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PatchAuthorReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PatchAuthorReq.Merge(m, src)
+}
+func (m *PatchAuthorReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *PatchAuthorReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_PatchAuthorReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PatchAuthorReq proto.InternalMessageInfo
+
+func (m *PatchAuthorReq) GetAuthor() *Author {
+	if m != nil {
+		return m.Author
+	}
+	return nil
+}
+
+func (m *PatchAuthorReq) GetUpdateMask() *types.FieldMask {
+	if m != nil {
+		return m.UpdateMask
+	}
+	return nil
+}
+
 type ListAuthorReq struct {
 	Page                 int64    `protobuf:"varint,1,opt,name=page,proto3" json:"page"`
 	Limit                int64    `protobuf:"varint,2,opt,name=limit,proto3" json:"limit"`
+	NamePrefix           string   `protobuf:"bytes,3,opt,name=name_prefix,json=namePrefix,proto3" json:"name_prefix"`
+	Cursor               string   `protobuf:"bytes,4,opt,name=cursor,proto3" json:"cursor"`
+	Deleted              string   `protobuf:"bytes,5,opt,name=deleted,proto3" json:"deleted"`
+	Ids                  []string `protobuf:"bytes,6,rep,name=ids,proto3" json:"ids"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -160,7 +228,7 @@ func (m *ListAuthorReq) Reset()         { *m = ListAuthorReq{} }
 func (m *ListAuthorReq) String() string { return proto.CompactTextString(m) }
 func (*ListAuthorReq) ProtoMessage()    {}
 func (*ListAuthorReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bd39da4a78905a51, []int{2}
+	return fileDescriptor_bd39da4a78905a51, []int{3}
 }
 func (m *ListAuthorReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -203,9 +271,38 @@ func (m *ListAuthorReq) GetLimit() int64 {
 	return 0
 }
 
+func (m *ListAuthorReq) GetNamePrefix() string {
+	if m != nil {
+		return m.NamePrefix
+	}
+	return ""
+}
+
+func (m *ListAuthorReq) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
+func (m *ListAuthorReq) GetDeleted() string {
+	if m != nil {
+		return m.Deleted
+	}
+	return ""
+}
+
+func (m *ListAuthorReq) GetIds() []string {
+	if m != nil {
+		return m.Ids
+	}
+	return nil
+}
+
 type ListAuthorResp struct {
 	Authors              []*Author `protobuf:"bytes,1,rep,name=authors,proto3" json:"authors"`
 	Count                int64     `protobuf:"varint,2,opt,name=count,proto3" json:"count"`
+	NextCursor           string    `protobuf:"bytes,3,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor"`
 	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
 	XXX_unrecognized     []byte    `json:"-"`
 	XXX_sizecache        int32     `json:"-"`
@@ -215,7 +312,7 @@ func (m *ListAuthorResp) Reset()         { *m = ListAuthorResp{} }
 func (m *ListAuthorResp) String() string { return proto.CompactTextString(m) }
 func (*ListAuthorResp) ProtoMessage()    {}
 func (*ListAuthorResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bd39da4a78905a51, []int{3}
+	return fileDescriptor_bd39da4a78905a51, []int{4}
 }
 func (m *ListAuthorResp) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -258,9 +355,17 @@ func (m *ListAuthorResp) GetCount() int64 {
 	return 0
 }
 
+func (m *ListAuthorResp) GetNextCursor() string {
+	if m != nil {
+		return m.NextCursor
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Author)(nil), "catalog.Author")
 	proto.RegisterType((*GetAuthorByIdReq)(nil), "catalog.GetAuthorByIdReq")
+	proto.RegisterType((*PatchAuthorReq)(nil), "catalog.PatchAuthorReq")
 	proto.RegisterType((*ListAuthorReq)(nil), "catalog.ListAuthorReq")
 	proto.RegisterType((*ListAuthorResp)(nil), "catalog.ListAuthorResp")
 }
@@ -268,24 +373,34 @@ func init() {
 func init() { proto.RegisterFile("catalog_service/author.proto", fileDescriptor_bd39da4a78905a51) }
 
 var fileDescriptor_bd39da4a78905a51 = []byte{
-	// 266 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x90, 0xc1, 0x4a, 0xc3, 0x40,
-	0x10, 0x86, 0xdd, 0x24, 0x6d, 0xe9, 0x88, 0xb5, 0x2c, 0x1e, 0x72, 0xd0, 0x50, 0x72, 0xaa, 0x97,
-	0x08, 0x7a, 0xf2, 0x98, 0x5e, 0x44, 0xf0, 0x62, 0x5e, 0xa0, 0xac, 0xd9, 0xa1, 0x2e, 0xa4, 0xdd,
-	0x98, 0x4c, 0x04, 0x1f, 0xc1, 0x37, 0xf0, 0x91, 0x3c, 0xfa, 0x08, 0x12, 0x5f, 0x44, 0x32, 0xbb,
-	0x15, 0xe9, 0x6d, 0xe6, 0xff, 0xfe, 0x19, 0x7e, 0x7e, 0x38, 0x2f, 0x15, 0xa9, 0xca, 0x6e, 0xd6,
-	0x2d, 0x36, 0xaf, 0xa6, 0xc4, 0x2b, 0xd5, 0xd1, 0xb3, 0x6d, 0xb2, 0xba, 0xb1, 0x64, 0xe5, 0xc4,
-	0xd3, 0xf4, 0x5d, 0xc0, 0x38, 0x67, 0x22, 0x67, 0x10, 0x18, 0x1d, 0x8b, 0x85, 0x58, 0x4e, 0x8b,
-	0xc0, 0x68, 0x29, 0x21, 0xda, 0xa9, 0x2d, 0xc6, 0x01, 0x2b, 0x3c, 0xcb, 0x0b, 0x80, 0xb2, 0x41,
-	0x45, 0xa8, 0xd7, 0x8a, 0xe2, 0x90, 0xc9, 0xd4, 0x2b, 0x39, 0x0d, 0xb8, 0xab, 0xf5, 0x1e, 0x47,
-	0x0e, 0x7b, 0xc5, 0x61, 0x8d, 0x15, 0x7a, 0x3c, 0x72, 0xd8, 0x2b, 0x39, 0xa5, 0x29, 0xcc, 0xef,
-	0x90, 0x5c, 0x9a, 0xd5, 0xdb, 0xbd, 0x2e, 0xf0, 0xe5, 0x30, 0x54, 0x7a, 0x0b, 0x27, 0x0f, 0xa6,
-	0xf5, 0xa6, 0xc1, 0x20, 0x21, 0xaa, 0xd5, 0x06, 0xd9, 0x12, 0x16, 0x3c, 0xcb, 0x33, 0x18, 0x55,
-	0x66, 0x6b, 0x88, 0xa3, 0x87, 0x85, 0x5b, 0xd2, 0x47, 0x98, 0xfd, 0x3f, 0x6d, 0x6b, 0x79, 0x09,
-	0x13, 0xd7, 0x4a, 0x1b, 0x8b, 0x45, 0xb8, 0x3c, 0xbe, 0x3e, 0xcd, 0x7c, 0x2f, 0x99, 0x77, 0xed,
-	0xf9, 0xf0, 0xb2, 0xb4, 0xdd, 0xee, 0xef, 0x25, 0x2f, 0xab, 0xf9, 0x67, 0x9f, 0x88, 0xaf, 0x3e,
-	0x11, 0xdf, 0x7d, 0x22, 0x3e, 0x7e, 0x92, 0xa3, 0xa7, 0x31, 0xf7, 0x7b, 0xf3, 0x1b, 0x00, 0x00,
-	0xff, 0xff, 0x0f, 0xcd, 0x7e, 0xea, 0x7f, 0x01, 0x00, 0x00,
+	// 417 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x90, 0xcd, 0x8a, 0xd4, 0x40,
+	0x14, 0x85, 0xad, 0x4e, 0x4f, 0x9a, 0xbe, 0xc1, 0xb6, 0x2d, 0x45, 0xc2, 0xa0, 0x31, 0x64, 0xe3,
+	0xb8, 0x49, 0xc3, 0xb8, 0x74, 0xd5, 0x23, 0x28, 0x82, 0xc2, 0x10, 0x70, 0x1d, 0x6a, 0x52, 0xb7,
+	0x33, 0xc5, 0xa4, 0xa7, 0x62, 0x55, 0x65, 0x88, 0x8f, 0xe0, 0x1b, 0xb8, 0xf2, 0x79, 0x5c, 0xfa,
+	0x08, 0xd2, 0xbe, 0x88, 0xd4, 0x4f, 0x74, 0xc0, 0xd9, 0xdd, 0x7b, 0x4e, 0x72, 0xcf, 0xa9, 0x0f,
+	0x9e, 0x36, 0xcc, 0xb0, 0x4e, 0xb6, 0xb5, 0x46, 0x75, 0x23, 0x1a, 0xdc, 0xb0, 0xc1, 0x5c, 0x4a,
+	0x55, 0xf6, 0x4a, 0x1a, 0x49, 0x17, 0xc1, 0x3d, 0xce, 0x5b, 0x29, 0xdb, 0x0e, 0x37, 0x4e, 0xbe,
+	0x18, 0x76, 0x9b, 0x9d, 0xc0, 0x8e, 0xd7, 0x7b, 0xa6, 0xaf, 0xfc, 0xa7, 0xc5, 0x57, 0x02, 0xf1,
+	0xd6, 0xfd, 0x4b, 0x57, 0x30, 0x13, 0x3c, 0x25, 0x39, 0x39, 0x59, 0x56, 0x33, 0xc1, 0x29, 0x85,
+	0xf9, 0x35, 0xdb, 0x63, 0x3a, 0x73, 0x8a, 0x9b, 0xe9, 0x33, 0x80, 0x46, 0x21, 0x33, 0xc8, 0x6b,
+	0x66, 0xd2, 0xc8, 0x39, 0xcb, 0xa0, 0x6c, 0x8d, 0xb5, 0x87, 0x9e, 0x4f, 0xf6, 0xdc, 0xdb, 0x41,
+	0xf1, 0x36, 0xc7, 0x0e, 0x83, 0x7d, 0xe4, 0xed, 0xa0, 0x6c, 0x4d, 0x51, 0xc1, 0xfa, 0x1d, 0x1a,
+	0xdf, 0xe6, 0xec, 0xcb, 0x7b, 0x5e, 0xe1, 0xe7, 0xff, 0x4a, 0x95, 0xf0, 0x08, 0xc7, 0x1e, 0x1b,
+	0x7b, 0xe3, 0x56, 0x94, 0xef, 0xf8, 0x70, 0xb2, 0x3e, 0x4d, 0x91, 0xc5, 0x0d, 0xac, 0xce, 0x99,
+	0x69, 0x2e, 0xfd, 0x55, 0x7b, 0xf1, 0x05, 0xc4, 0x1e, 0x96, 0xbb, 0x9a, 0x9c, 0x3e, 0x28, 0x03,
+	0xad, 0x32, 0x7c, 0x13, 0x6c, 0xfa, 0x1a, 0x12, 0x9f, 0xe0, 0x78, 0xb9, 0x88, 0xe4, 0xf4, 0xb8,
+	0xf4, 0x48, 0xcb, 0x09, 0x69, 0xf9, 0xd6, 0x22, 0xfd, 0xc8, 0xf4, 0x55, 0x15, 0xde, 0x6e, 0xe7,
+	0xe2, 0x3b, 0x81, 0xfb, 0x1f, 0x84, 0x36, 0xff, 0x72, 0x29, 0xcc, 0x7b, 0xd6, 0xa2, 0x4b, 0x8d,
+	0x2a, 0x37, 0xd3, 0xc7, 0x70, 0xd4, 0x89, 0xbd, 0xf0, 0xfd, 0xa3, 0xca, 0x2f, 0xf4, 0x39, 0x24,
+	0x16, 0x76, 0xdd, 0x2b, 0xdc, 0x89, 0x31, 0x50, 0x06, 0x2b, 0x9d, 0x3b, 0x85, 0x3e, 0x81, 0xb8,
+	0x19, 0x94, 0x96, 0x2a, 0x20, 0x0e, 0x1b, 0x4d, 0x61, 0x11, 0x68, 0x06, 0xb8, 0xd3, 0x4a, 0xd7,
+	0x10, 0x09, 0xae, 0xd3, 0x38, 0x8f, 0x4e, 0x96, 0x95, 0x1d, 0x0b, 0x05, 0xab, 0xdb, 0xfd, 0x74,
+	0x4f, 0x5f, 0xc2, 0xc2, 0xbf, 0x5c, 0xa7, 0x24, 0x8f, 0xee, 0x22, 0x33, 0xf9, 0xb6, 0x77, 0x23,
+	0x87, 0xeb, 0xbf, 0xbd, 0xdd, 0xe2, 0x7a, 0xe3, 0x68, 0xea, 0xd0, 0x6d, 0xea, 0x8d, 0xa3, 0x79,
+	0xe3, 0x94, 0xb3, 0xf5, 0x8f, 0x43, 0x46, 0x7e, 0x1e, 0x32, 0xf2, 0xeb, 0x90, 0x91, 0x6f, 0xbf,
+	0xb3, 0x7b, 0x17, 0xb1, 0xc3, 0xf8, 0xea, 0xcf, 0x00, 0x88, 0xea, 0xe5, 0x14, 0xd0, 0x02, 0x00,
+	0x00,
 }
 
 func (m *Author) Marshal() (dAtA []byte, err error) {
@@ -374,6 +489,13 @@ func (m *GetAuthorByIdReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.ExpectedUpdatedAt) > 0 {
+		i -= len(m.ExpectedUpdatedAt)
+		copy(dAtA[i:], m.ExpectedUpdatedAt)
+		i = encodeVarintAuthor(dAtA, i, uint64(len(m.ExpectedUpdatedAt)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Id) > 0 {
 		i -= len(m.Id)
 		copy(dAtA[i:], m.Id)
@@ -384,6 +506,57 @@ func (m *GetAuthorByIdReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PatchAuthorReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PatchAuthorReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PatchAuthorReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.UpdateMask != nil {
+		{
+			size, err := m.UpdateMask.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuthor(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Author != nil {
+		{
+			size, err := m.Author.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuthor(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ListAuthorReq) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -408,6 +581,36 @@ func (m *ListAuthorReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Ids) > 0 {
+		for iNdEx := len(m.Ids) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Ids[iNdEx])
+			copy(dAtA[i:], m.Ids[iNdEx])
+			i = encodeVarintAuthor(dAtA, i, uint64(len(m.Ids[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Deleted) > 0 {
+		i -= len(m.Deleted)
+		copy(dAtA[i:], m.Deleted)
+		i = encodeVarintAuthor(dAtA, i, uint64(len(m.Deleted)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Cursor) > 0 {
+		i -= len(m.Cursor)
+		copy(dAtA[i:], m.Cursor)
+		i = encodeVarintAuthor(dAtA, i, uint64(len(m.Cursor)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.NamePrefix) > 0 {
+		i -= len(m.NamePrefix)
+		copy(dAtA[i:], m.NamePrefix)
+		i = encodeVarintAuthor(dAtA, i, uint64(len(m.NamePrefix)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.Limit != 0 {
 		i = encodeVarintAuthor(dAtA, i, uint64(m.Limit))
 		i--
@@ -445,6 +648,13 @@ func (m *ListAuthorResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.NextCursor) > 0 {
+		i -= len(m.NextCursor)
+		copy(dAtA[i:], m.NextCursor)
+		i = encodeVarintAuthor(dAtA, i, uint64(len(m.NextCursor)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.Count != 0 {
 		i = encodeVarintAuthor(dAtA, i, uint64(m.Count))
 		i--
@@ -520,6 +730,30 @@ func (m *GetAuthorByIdReq) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovAuthor(uint64(l))
 	}
+	l = len(m.ExpectedUpdatedAt)
+	if l > 0 {
+		n += 1 + l + sovAuthor(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PatchAuthorReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Author != nil {
+		l = m.Author.Size()
+		n += 1 + l + sovAuthor(uint64(l))
+	}
+	if m.UpdateMask != nil {
+		l = m.UpdateMask.Size()
+		n += 1 + l + sovAuthor(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -538,6 +772,24 @@ func (m *ListAuthorReq) Size() (n int) {
 	if m.Limit != 0 {
 		n += 1 + sovAuthor(uint64(m.Limit))
 	}
+	l = len(m.NamePrefix)
+	if l > 0 {
+		n += 1 + l + sovAuthor(uint64(l))
+	}
+	l = len(m.Cursor)
+	if l > 0 {
+		n += 1 + l + sovAuthor(uint64(l))
+	}
+	l = len(m.Deleted)
+	if l > 0 {
+		n += 1 + l + sovAuthor(uint64(l))
+	}
+	if len(m.Ids) > 0 {
+		for _, s := range m.Ids {
+			l = len(s)
+			n += 1 + l + sovAuthor(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -559,6 +811,10 @@ func (m *ListAuthorResp) Size() (n int) {
 	if m.Count != 0 {
 		n += 1 + sovAuthor(uint64(m.Count))
 	}
+	l = len(m.NextCursor)
+	if l > 0 {
+		n += 1 + l + sovAuthor(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -843,6 +1099,161 @@ func (m *GetAuthorByIdReq) Unmarshal(dAtA []byte) error {
 			}
 			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedUpdatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpectedUpdatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PatchAuthorReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PatchAuthorReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PatchAuthorReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Author", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Author == nil {
+				m.Author = &Author{}
+			}
+			if err := m.Author.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateMask", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UpdateMask == nil {
+				m.UpdateMask = &types.FieldMask{}
+			}
+			if err := m.UpdateMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuthor(dAtA[iNdEx:])
@@ -932,6 +1343,134 @@ func (m *ListAuthorReq) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamePrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamePrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cursor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cursor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deleted", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deleted = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ids = append(m.Ids, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuthor(dAtA[iNdEx:])
@@ -1036,6 +1575,38 @@ func (m *ListAuthorResp) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextCursor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextCursor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuthor(dAtA[iNdEx:])
@@ -5,6 +5,7 @@ package catalog
 
 import (
 	fmt "fmt"
+	types "github.com/gogo/protobuf/types"
 	proto "github.com/golang/protobuf/proto"
 	io "io"
 	math "math"
@@ -158,6 +159,7 @@ func (m *Book) GetDeletedAt() string {
 
 type GetBookByIdReq struct {
 	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	ExpectedUpdatedAt    string   `protobuf:"bytes,2,opt,name=expected_updated_at,json=expectedUpdatedAt,proto3" json:"expected_updated_at"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -203,10 +205,136 @@ func (m *GetBookByIdReq) GetId() string {
 	return ""
 }
 
+func (m *GetBookByIdReq) GetExpectedUpdatedAt() string {
+	if m != nil {
+		return m.ExpectedUpdatedAt
+	}
+	return ""
+}
+
+type PatchBookReq struct {
+	Book                 *Book            `protobuf:"bytes,1,opt,name=book,proto3" json:"book"`
+	UpdateMask           *types.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *PatchBookReq) Reset()         { *m = PatchBookReq{} }
+func (m *PatchBookReq) String() string { return proto.CompactTextString(m) }
+func (*PatchBookReq) ProtoMessage()    {}
+func (*PatchBookReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_40f236e04b1afcdb, []int{3}
+}
+func (m *PatchBookReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PatchBookReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PatchBookReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PatchBookReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PatchBookReq.Merge(m, src)
+}
+func (m *PatchBookReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *PatchBookReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_PatchBookReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PatchBookReq proto.InternalMessageInfo
+
+func (m *PatchBookReq) GetBook() *Book {
+	if m != nil {
+		return m.Book
+	}
+	return nil
+}
+
+func (m *PatchBookReq) GetUpdateMask() *types.FieldMask {
+	if m != nil {
+		return m.UpdateMask
+	}
+	return nil
+}
+
+type SortField struct {
+	Field                string   `protobuf:"bytes,1,opt,name=field,proto3" json:"field"`
+	Desc                 bool     `protobuf:"varint,2,opt,name=desc,proto3" json:"desc"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SortField) Reset()         { *m = SortField{} }
+func (m *SortField) String() string { return proto.CompactTextString(m) }
+func (*SortField) ProtoMessage()    {}
+func (*SortField) Descriptor() ([]byte, []int) {
+	return fileDescriptor_40f236e04b1afcdb, []int{4}
+}
+func (m *SortField) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SortField) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SortField.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SortField) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SortField.Merge(m, src)
+}
+func (m *SortField) XXX_Size() int {
+	return m.Size()
+}
+func (m *SortField) XXX_DiscardUnknown() {
+	xxx_messageInfo_SortField.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SortField proto.InternalMessageInfo
+
+func (m *SortField) GetField() string {
+	if m != nil {
+		return m.Field
+	}
+	return ""
+}
+
+func (m *SortField) GetDesc() bool {
+	if m != nil {
+		return m.Desc
+	}
+	return false
+}
+
 type ListBookReq struct {
 	Filters              map[string]string `protobuf:"bytes,1,rep,name=filters,proto3" json:"filters" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	Page                 int64             `protobuf:"varint,2,opt,name=page,proto3" json:"page"`
 	Limit                int64             `protobuf:"varint,3,opt,name=limit,proto3" json:"limit"`
+	Search               string            `protobuf:"bytes,4,opt,name=search,proto3" json:"search"`
+	Ordering             []*SortField      `protobuf:"bytes,5,rep,name=ordering,proto3" json:"ordering"`
+	Cursor               string            `protobuf:"bytes,6,opt,name=cursor,proto3" json:"cursor"`
+	AuthorId             string            `protobuf:"bytes,7,opt,name=author_id,json=authorId,proto3" json:"author_id"`
+	CategoryIds          []string          `protobuf:"bytes,8,rep,name=category_ids,json=categoryIds,proto3" json:"category_ids"`
+	MatchAllCategories   bool              `protobuf:"varint,9,opt,name=match_all_categories,json=matchAllCategories,proto3" json:"match_all_categories"`
+	Deleted              string            `protobuf:"bytes,10,opt,name=deleted,proto3" json:"deleted"`
+	IncludeDescendants   bool              `protobuf:"varint,11,opt,name=include_descendants,json=includeDescendants,proto3" json:"include_descendants"`
+	Ids                  []string          `protobuf:"bytes,12,rep,name=ids,proto3" json:"ids"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
@@ -216,7 +344,7 @@ func (m *ListBookReq) Reset()         { *m = ListBookReq{} }
 func (m *ListBookReq) String() string { return proto.CompactTextString(m) }
 func (*ListBookReq) ProtoMessage()    {}
 func (*ListBookReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_40f236e04b1afcdb, []int{3}
+	return fileDescriptor_40f236e04b1afcdb, []int{5}
 }
 func (m *ListBookReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -266,9 +394,73 @@ func (m *ListBookReq) GetLimit() int64 {
 	return 0
 }
 
+func (m *ListBookReq) GetSearch() string {
+	if m != nil {
+		return m.Search
+	}
+	return ""
+}
+
+func (m *ListBookReq) GetOrdering() []*SortField {
+	if m != nil {
+		return m.Ordering
+	}
+	return nil
+}
+
+func (m *ListBookReq) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
+func (m *ListBookReq) GetAuthorId() string {
+	if m != nil {
+		return m.AuthorId
+	}
+	return ""
+}
+
+func (m *ListBookReq) GetCategoryIds() []string {
+	if m != nil {
+		return m.CategoryIds
+	}
+	return nil
+}
+
+func (m *ListBookReq) GetMatchAllCategories() bool {
+	if m != nil {
+		return m.MatchAllCategories
+	}
+	return false
+}
+
+func (m *ListBookReq) GetDeleted() string {
+	if m != nil {
+		return m.Deleted
+	}
+	return ""
+}
+
+func (m *ListBookReq) GetIncludeDescendants() bool {
+	if m != nil {
+		return m.IncludeDescendants
+	}
+	return false
+}
+
+func (m *ListBookReq) GetIds() []string {
+	if m != nil {
+		return m.Ids
+	}
+	return nil
+}
+
 type ListBookResp struct {
 	Books                []*Book  `protobuf:"bytes,1,rep,name=books,proto3" json:"books"`
 	Count                int64    `protobuf:"varint,2,opt,name=count,proto3" json:"count"`
+	NextCursor           string   `protobuf:"bytes,3,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -278,7 +470,7 @@ func (m *ListBookResp) Reset()         { *m = ListBookResp{} }
 func (m *ListBookResp) String() string { return proto.CompactTextString(m) }
 func (*ListBookResp) ProtoMessage()    {}
 func (*ListBookResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_40f236e04b1afcdb, []int{4}
+	return fileDescriptor_40f236e04b1afcdb, []int{6}
 }
 func (m *ListBookResp) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -321,10 +513,19 @@ func (m *ListBookResp) GetCount() int64 {
 	return 0
 }
 
+func (m *ListBookResp) GetNextCursor() string {
+	if m != nil {
+		return m.NextCursor
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*EmptyResp)(nil), "catalog.EmptyResp")
 	proto.RegisterType((*Book)(nil), "catalog.Book")
 	proto.RegisterType((*GetBookByIdReq)(nil), "catalog.GetBookByIdReq")
+	proto.RegisterType((*PatchBookReq)(nil), "catalog.PatchBookReq")
+	proto.RegisterType((*SortField)(nil), "catalog.SortField")
 	proto.RegisterType((*ListBookReq)(nil), "catalog.ListBookReq")
 	proto.RegisterMapType((map[string]string)(nil), "catalog.ListBookReq.FiltersEntry")
 	proto.RegisterType((*ListBookResp)(nil), "catalog.ListBookResp")
@@ -333,31 +534,46 @@ func init() {
 func init() { proto.RegisterFile("catalog_service/book.proto", fileDescriptor_40f236e04b1afcdb) }
 
 var fileDescriptor_40f236e04b1afcdb = []byte{
-	// 370 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x92, 0x4f, 0x6a, 0xdb, 0x40,
-	0x14, 0xc6, 0x3b, 0x92, 0xff, 0x54, 0x4f, 0xae, 0x31, 0x43, 0x17, 0xc2, 0xa5, 0xaa, 0xaa, 0x6e,
-	0xbc, 0x52, 0xa1, 0xdd, 0x14, 0x77, 0x65, 0x83, 0x13, 0x04, 0x59, 0xe9, 0x02, 0x66, 0xac, 0x99,
-	0x38, 0x83, 0x65, 0x8f, 0x22, 0x8d, 0x0c, 0xba, 0x49, 0x8e, 0x91, 0x4b, 0x04, 0xb2, 0xcc, 0x11,
-	0x82, 0x73, 0x91, 0x30, 0x7f, 0x94, 0x98, 0xec, 0xde, 0xfb, 0x7e, 0xef, 0x0d, 0xdf, 0xfb, 0x24,
-	0x98, 0xe6, 0x44, 0x92, 0x42, 0x6c, 0xd7, 0x35, 0xab, 0x8e, 0x3c, 0x67, 0xbf, 0x37, 0x42, 0xec,
-	0x92, 0xb2, 0x12, 0x52, 0xe0, 0xa1, 0x65, 0xb1, 0x0f, 0xde, 0x6a, 0x5f, 0xca, 0x36, 0x63, 0x75,
-	0x19, 0x3f, 0x20, 0xe8, 0x2d, 0x85, 0xd8, 0xe1, 0x31, 0x38, 0x9c, 0x06, 0x28, 0x42, 0x33, 0x2f,
-	0x73, 0x38, 0xc5, 0x18, 0x7a, 0x07, 0xb2, 0x67, 0x81, 0xa3, 0x15, 0x5d, 0xe3, 0x6f, 0xe0, 0x91,
-	0x46, 0xde, 0x88, 0x6a, 0xcd, 0x69, 0xe0, 0x6a, 0xf0, 0xd9, 0x08, 0x29, 0xc5, 0x3f, 0xc0, 0xcf,
-	0x89, 0x64, 0x5b, 0x51, 0xb5, 0x0a, 0xf7, 0x22, 0x77, 0xe6, 0x65, 0xd0, 0x49, 0x29, 0xc5, 0xdf,
-	0x01, 0xf2, 0x8a, 0x11, 0xc9, 0xe8, 0x9a, 0xc8, 0xa0, 0xaf, 0xd7, 0x3d, 0xab, 0x2c, 0xa4, 0xc2,
-	0x4d, 0x49, 0x3b, 0x3c, 0x30, 0xd8, 0x2a, 0x06, 0x53, 0x56, 0x30, 0x8b, 0x87, 0x06, 0x5b, 0x65,
-	0x21, 0xe3, 0x08, 0xc6, 0x97, 0x4c, 0xaa, 0x4b, 0x96, 0x6d, 0x4a, 0x33, 0x76, 0xfb, 0xf1, 0xa0,
-	0xf8, 0x1e, 0x81, 0x7f, 0xc5, 0x6b, 0x3d, 0xa3, 0xf8, 0x7f, 0x18, 0x5e, 0xf3, 0x42, 0xb2, 0xaa,
-	0x0e, 0x50, 0xe4, 0xce, 0xfc, 0x3f, 0x3f, 0x13, 0x9b, 0x50, 0x72, 0x36, 0x96, 0x5c, 0x98, 0x99,
-	0xd5, 0x41, 0x56, 0x6d, 0xd6, 0x6d, 0xa8, 0x74, 0x4a, 0xb2, 0x35, 0xe9, 0xb8, 0x99, 0xae, 0xf1,
-	0x57, 0xe8, 0x17, 0x7c, 0xcf, 0xa5, 0x4e, 0xc6, 0xcd, 0x4c, 0x33, 0x9d, 0xc3, 0xe8, 0xfc, 0x09,
-	0x3c, 0x01, 0x77, 0xc7, 0x5a, 0xeb, 0x4b, 0x95, 0x6a, 0xef, 0x48, 0x8a, 0xa6, 0x8b, 0xda, 0x34,
-	0x73, 0xe7, 0x1f, 0x8a, 0x53, 0x18, 0xbd, 0x5b, 0xa9, 0x4b, 0xfc, 0x0b, 0xfa, 0xea, 0x83, 0x76,
-	0x86, 0xbf, 0xbc, 0x19, 0xd6, 0x13, 0x86, 0xa9, 0xe7, 0x72, 0xd1, 0x1c, 0xa4, 0xf5, 0x66, 0x9a,
-	0xe5, 0xe4, 0xf1, 0x14, 0xa2, 0xa7, 0x53, 0x88, 0x9e, 0x4f, 0x21, 0xba, 0x7b, 0x09, 0x3f, 0x6d,
-	0x06, 0xfa, 0xb7, 0xf8, 0xfb, 0x1a, 0x00, 0x00, 0xff, 0xff, 0x69, 0xb8, 0x50, 0xe1, 0x34, 0x02,
-	0x00, 0x00,
+	// 621 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x53, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0xc6, 0x71, 0xd2, 0xc4, 0xe3, 0x50, 0x95, 0x6d, 0x85, 0xac, 0x20, 0x42, 0x6a, 0x2e, 0x3d,
+	0x39, 0xa8, 0x08, 0x09, 0xb5, 0xa7, 0xb6, 0xb4, 0xa8, 0x12, 0x48, 0x95, 0x11, 0x67, 0x6b, 0xeb,
+	0xdd, 0xa6, 0x26, 0x8e, 0xd7, 0xec, 0xae, 0xab, 0xe6, 0x4d, 0x78, 0x21, 0x24, 0x8e, 0xbc, 0x01,
+	0xa8, 0xbc, 0x08, 0xda, 0xd9, 0x75, 0x1a, 0xca, 0x6d, 0x66, 0xbe, 0xd9, 0x6f, 0x7e, 0xbe, 0x59,
+	0x18, 0xe5, 0x54, 0xd3, 0x52, 0xcc, 0x32, 0xc5, 0xe5, 0x4d, 0x91, 0xf3, 0xe9, 0xa5, 0x10, 0xf3,
+	0xa4, 0x96, 0x42, 0x0b, 0xd2, 0x77, 0xd8, 0x68, 0x32, 0x13, 0x62, 0x56, 0xf2, 0x29, 0x86, 0x2f,
+	0x9b, 0xab, 0xe9, 0x55, 0xc1, 0x4b, 0x96, 0x2d, 0xa8, 0x72, 0xa9, 0x71, 0x08, 0xc1, 0xe9, 0xa2,
+	0xd6, 0xcb, 0x94, 0xab, 0x3a, 0xfe, 0xee, 0x41, 0xf7, 0x58, 0x88, 0x39, 0xd9, 0x84, 0x4e, 0xc1,
+	0x22, 0x6f, 0xe2, 0xed, 0x05, 0x69, 0xa7, 0x60, 0x84, 0x40, 0xb7, 0xa2, 0x0b, 0x1e, 0x75, 0x30,
+	0x82, 0x36, 0x79, 0x06, 0x01, 0x6d, 0xf4, 0xb5, 0x90, 0x59, 0xc1, 0x22, 0x1f, 0x81, 0x81, 0x0d,
+	0x9c, 0x33, 0xf2, 0x02, 0xc2, 0x9c, 0x6a, 0x3e, 0x13, 0x72, 0x69, 0xe0, 0xee, 0xc4, 0xdf, 0x0b,
+	0x52, 0x68, 0x43, 0xe7, 0x8c, 0x3c, 0x07, 0xc8, 0x25, 0xa7, 0x9a, 0xb3, 0x8c, 0xea, 0xa8, 0x87,
+	0xcf, 0x03, 0x17, 0x39, 0xd2, 0x06, 0x6e, 0x6a, 0xd6, 0xc2, 0x1b, 0x16, 0x76, 0x11, 0x0b, 0x33,
+	0x5e, 0x72, 0x07, 0xf7, 0x2d, 0xec, 0x22, 0x47, 0x3a, 0xbe, 0x80, 0xcd, 0xf7, 0x5c, 0x9b, 0x49,
+	0x8e, 0x97, 0xe7, 0x2c, 0xe5, 0x5f, 0xff, 0x1b, 0x28, 0x81, 0x6d, 0x7e, 0x5b, 0xf3, 0xdc, 0x30,
+	0xac, 0x15, 0xb2, 0xf3, 0x3d, 0x69, 0xa1, 0xcf, 0x6d, 0xc1, 0xb8, 0x82, 0xe1, 0x05, 0xd5, 0xf9,
+	0xb5, 0xe1, 0x34, 0x7c, 0xbb, 0xd0, 0x35, 0xfb, 0x46, 0xc6, 0x70, 0xff, 0x71, 0xe2, 0x16, 0x9e,
+	0x20, 0x8e, 0x10, 0x39, 0x84, 0xd0, 0x32, 0xe3, 0xba, 0x91, 0x3a, 0xdc, 0x1f, 0x25, 0x56, 0x91,
+	0xa4, 0x55, 0x24, 0x39, 0x33, 0x8a, 0x7c, 0xa4, 0x6a, 0x9e, 0xba, 0x89, 0x8d, 0x1d, 0xbf, 0x81,
+	0xe0, 0x93, 0x90, 0x1a, 0x41, 0xb2, 0x03, 0x3d, 0xd4, 0xcd, 0xf5, 0x6f, 0x1d, 0xa3, 0x09, 0xe3,
+	0x2a, 0x47, 0xe2, 0x41, 0x8a, 0x76, 0xfc, 0xcb, 0x87, 0xf0, 0x43, 0xa1, 0x74, 0xdb, 0xe6, 0x21,
+	0xf4, 0xaf, 0x8a, 0x52, 0x73, 0xa9, 0x22, 0x6f, 0xe2, 0xef, 0x85, 0xfb, 0xbb, 0xab, 0x4e, 0xd7,
+	0xd2, 0x92, 0x33, 0x9b, 0x73, 0x5a, 0x69, 0xb9, 0x4c, 0xdb, 0x17, 0xa6, 0x40, 0x4d, 0x67, 0x56,
+	0x74, 0x3f, 0x45, 0xdb, 0xb4, 0x52, 0x16, 0x8b, 0x42, 0xa3, 0xe0, 0x7e, 0x6a, 0x1d, 0xf2, 0x14,
+	0x36, 0x14, 0xa7, 0x32, 0xbf, 0x8e, 0xba, 0xd8, 0xa1, 0xf3, 0x48, 0x02, 0x03, 0x21, 0x19, 0x97,
+	0x45, 0x35, 0x8b, 0x7a, 0x58, 0x9f, 0xac, 0xea, 0xaf, 0xc6, 0x4b, 0x57, 0x39, 0x86, 0x27, 0x6f,
+	0xa4, 0x12, 0xd2, 0x29, 0xee, 0xbc, 0x7f, 0x4f, 0xad, 0xff, 0xe0, 0xd4, 0x76, 0x61, 0xb8, 0x76,
+	0x6a, 0x2a, 0x1a, 0xe0, 0xad, 0x85, 0xf7, 0xb7, 0xa6, 0xc8, 0x2b, 0xd8, 0x59, 0x18, 0xf5, 0x32,
+	0x5a, 0x96, 0x99, 0x03, 0x0a, 0xae, 0xa2, 0x00, 0x57, 0x47, 0x10, 0x3b, 0x2a, 0xcb, 0x93, 0x15,
+	0x42, 0x22, 0xe8, 0xbb, 0x73, 0x8a, 0x00, 0xeb, 0xb5, 0x2e, 0x99, 0xc2, 0x76, 0x51, 0xe5, 0x65,
+	0xc3, 0x78, 0x66, 0x56, 0xce, 0x2b, 0x46, 0x2b, 0xad, 0xa2, 0xd0, 0x52, 0x39, 0xe8, 0xdd, 0x3d,
+	0x42, 0xb6, 0xc0, 0x37, 0x6d, 0x0d, 0xb1, 0x2d, 0x63, 0x8e, 0x0e, 0x60, 0xb8, 0xbe, 0x71, 0x93,
+	0x31, 0xe7, 0x4b, 0xa7, 0xae, 0x31, 0xcd, 0x9a, 0x6f, 0x68, 0xd9, 0xb4, 0x1f, 0xce, 0x3a, 0x07,
+	0x9d, 0xb7, 0x5e, 0xfc, 0x05, 0x86, 0xf7, 0xca, 0xa9, 0x9a, 0xbc, 0x84, 0x9e, 0xb9, 0xb6, 0x56,
+	0xdf, 0x07, 0x97, 0x68, 0x31, 0x43, 0x97, 0x8b, 0xa6, 0xd2, 0x4e, 0x4a, 0xeb, 0x98, 0x3f, 0x5a,
+	0xf1, 0x5b, 0x9d, 0xb9, 0x95, 0xdb, 0x2f, 0x0c, 0x26, 0x74, 0x82, 0x91, 0xe3, 0xad, 0x1f, 0x77,
+	0x63, 0xef, 0xe7, 0xdd, 0xd8, 0xfb, 0x7d, 0x37, 0xf6, 0xbe, 0xfd, 0x19, 0x3f, 0xba, 0xdc, 0xc0,
+	0xb3, 0x7d, 0xfd, 0x77, 0x00, 0x41, 0xa4, 0x9f, 0x6e, 0x7d, 0x04, 0x00, 0x00,
 }
 
 func (m *EmptyResp) Marshal() (dAtA []byte, err error) {
@@ -489,6 +705,13 @@ func (m *GetBookByIdReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.ExpectedUpdatedAt) > 0 {
+		i -= len(m.ExpectedUpdatedAt)
+		copy(dAtA[i:], m.ExpectedUpdatedAt)
+		i = encodeVarintBook(dAtA, i, uint64(len(m.ExpectedUpdatedAt)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Id) > 0 {
 		i -= len(m.Id)
 		copy(dAtA[i:], m.Id)
@@ -499,6 +722,101 @@ func (m *GetBookByIdReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PatchBookReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PatchBookReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PatchBookReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.UpdateMask != nil {
+		{
+			size, err := m.UpdateMask.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBook(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Book != nil {
+		{
+			size, err := m.Book.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBook(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SortField) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SortField) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SortField) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Desc {
+		i--
+		if m.Desc {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Field) > 0 {
+		i -= len(m.Field)
+		copy(dAtA[i:], m.Field)
+		i = encodeVarintBook(dAtA, i, uint64(len(m.Field)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ListBookReq) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -523,6 +841,86 @@ func (m *ListBookReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Ids) > 0 {
+		for iNdEx := len(m.Ids) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Ids[iNdEx])
+			copy(dAtA[i:], m.Ids[iNdEx])
+			i = encodeVarintBook(dAtA, i, uint64(len(m.Ids[iNdEx])))
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if m.IncludeDescendants {
+		i--
+		if m.IncludeDescendants {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.Deleted) > 0 {
+		i -= len(m.Deleted)
+		copy(dAtA[i:], m.Deleted)
+		i = encodeVarintBook(dAtA, i, uint64(len(m.Deleted)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.MatchAllCategories {
+		i--
+		if m.MatchAllCategories {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.CategoryIds) > 0 {
+		for iNdEx := len(m.CategoryIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CategoryIds[iNdEx])
+			copy(dAtA[i:], m.CategoryIds[iNdEx])
+			i = encodeVarintBook(dAtA, i, uint64(len(m.CategoryIds[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.AuthorId) > 0 {
+		i -= len(m.AuthorId)
+		copy(dAtA[i:], m.AuthorId)
+		i = encodeVarintBook(dAtA, i, uint64(len(m.AuthorId)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Cursor) > 0 {
+		i -= len(m.Cursor)
+		copy(dAtA[i:], m.Cursor)
+		i = encodeVarintBook(dAtA, i, uint64(len(m.Cursor)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Ordering) > 0 {
+		for iNdEx := len(m.Ordering) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Ordering[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBook(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Search) > 0 {
+		i -= len(m.Search)
+		copy(dAtA[i:], m.Search)
+		i = encodeVarintBook(dAtA, i, uint64(len(m.Search)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.Limit != 0 {
 		i = encodeVarintBook(dAtA, i, uint64(m.Limit))
 		i--
@@ -579,6 +977,13 @@ func (m *ListBookResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.NextCursor) > 0 {
+		i -= len(m.NextCursor)
+		copy(dAtA[i:], m.NextCursor)
+		i = encodeVarintBook(dAtA, i, uint64(len(m.NextCursor)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.Count != 0 {
 		i = encodeVarintBook(dAtA, i, uint64(m.Count))
 		i--
@@ -676,31 +1081,29 @@ func (m *GetBookByIdReq) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovBook(uint64(l))
 	}
+	l = len(m.ExpectedUpdatedAt)
+	if l > 0 {
+		n += 1 + l + sovBook(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *ListBookReq) Size() (n int) {
+func (m *PatchBookReq) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Filters) > 0 {
-		for k, v := range m.Filters {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovBook(uint64(len(k))) + 1 + len(v) + sovBook(uint64(len(v)))
-			n += mapEntrySize + 1 + sovBook(uint64(mapEntrySize))
-		}
-	}
-	if m.Page != 0 {
-		n += 1 + sovBook(uint64(m.Page))
+	if m.Book != nil {
+		l = m.Book.Size()
+		n += 1 + l + sovBook(uint64(l))
 	}
-	if m.Limit != 0 {
-		n += 1 + sovBook(uint64(m.Limit))
+	if m.UpdateMask != nil {
+		l = m.UpdateMask.Size()
+		n += 1 + l + sovBook(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -708,21 +1111,110 @@ func (m *ListBookReq) Size() (n int) {
 	return n
 }
 
-func (m *ListBookResp) Size() (n int) {
+func (m *SortField) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Books) > 0 {
-		for _, e := range m.Books {
-			l = e.Size()
+	l = len(m.Field)
+	if l > 0 {
+		n += 1 + l + sovBook(uint64(l))
+	}
+	if m.Desc {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListBookReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Filters) > 0 {
+		for k, v := range m.Filters {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovBook(uint64(len(k))) + 1 + len(v) + sovBook(uint64(len(v)))
+			n += mapEntrySize + 1 + sovBook(uint64(mapEntrySize))
+		}
+	}
+	if m.Page != 0 {
+		n += 1 + sovBook(uint64(m.Page))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovBook(uint64(m.Limit))
+	}
+	l = len(m.Search)
+	if l > 0 {
+		n += 1 + l + sovBook(uint64(l))
+	}
+	if len(m.Ordering) > 0 {
+		for _, e := range m.Ordering {
+			l = e.Size()
+			n += 1 + l + sovBook(uint64(l))
+		}
+	}
+	l = len(m.Cursor)
+	if l > 0 {
+		n += 1 + l + sovBook(uint64(l))
+	}
+	l = len(m.AuthorId)
+	if l > 0 {
+		n += 1 + l + sovBook(uint64(l))
+	}
+	if len(m.CategoryIds) > 0 {
+		for _, s := range m.CategoryIds {
+			l = len(s)
+			n += 1 + l + sovBook(uint64(l))
+		}
+	}
+	if m.MatchAllCategories {
+		n += 2
+	}
+	l = len(m.Deleted)
+	if l > 0 {
+		n += 1 + l + sovBook(uint64(l))
+	}
+	if m.IncludeDescendants {
+		n += 2
+	}
+	if len(m.Ids) > 0 {
+		for _, s := range m.Ids {
+			l = len(s)
+			n += 1 + l + sovBook(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListBookResp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Books) > 0 {
+		for _, e := range m.Books {
+			l = e.Size()
 			n += 1 + l + sovBook(uint64(l))
 		}
 	}
 	if m.Count != 0 {
 		n += 1 + sovBook(uint64(m.Count))
 	}
+	l = len(m.NextCursor)
+	if l > 0 {
+		n += 1 + l + sovBook(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1122,6 +1614,38 @@ func (m *GetBookByIdReq) Unmarshal(dAtA []byte) error {
 			}
 			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedUpdatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBook
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBook
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpectedUpdatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBook(dAtA[iNdEx:])
@@ -1144,7 +1668,7 @@ func (m *GetBookByIdReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListBookReq) Unmarshal(dAtA []byte) error {
+func (m *PatchBookReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1167,15 +1691,15 @@ func (m *ListBookReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListBookReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: PatchBookReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListBookReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PatchBookReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Filters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Book", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1202,109 +1726,18 @@ func (m *ListBookReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Filters == nil {
-				m.Filters = make(map[string]string)
+			if m.Book == nil {
+				m.Book = &Book{}
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowBook
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowBook
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthBook
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthBook
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowBook
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthBook
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthBook
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipBook(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthBook
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.Book.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Filters[mapkey] = mapvalue
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateMask", wireType)
 			}
-			m.Page = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBook
@@ -1314,30 +1747,28 @@ func (m *ListBookReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Page |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthBook
 			}
-			m.Limit = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBook
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Limit |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBook
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UpdateMask == nil {
+				m.UpdateMask = &types.FieldMask{}
+			}
+			if err := m.UpdateMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBook(dAtA[iNdEx:])
@@ -1360,7 +1791,7 @@ func (m *ListBookReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListBookResp) Unmarshal(dAtA []byte) error {
+func (m *SortField) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1383,17 +1814,17 @@ func (m *ListBookResp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListBookResp: wiretype end group for non-group")
+			return fmt.Errorf("proto: SortField: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListBookResp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SortField: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Books", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBook
@@ -1403,23 +1834,608 @@ func (m *ListBookResp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthBook
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthBook
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Books = append(m.Books, &Book{})
-			if err := m.Books[len(m.Books)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Field = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Desc", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Desc = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBook(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBook
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListBookReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBook
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListBookReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListBookReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBook
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBook
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Filters == nil {
+				m.Filters = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBook
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowBook
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthBook
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthBook
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowBook
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthBook
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthBook
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipBook(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthBook
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Filters[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
+			}
+			m.Page = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Page |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Search", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBook
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBook
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Search = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ordering", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBook
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBook
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ordering = append(m.Ordering, &SortField{})
+			if err := m.Ordering[len(m.Ordering)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cursor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBook
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBook
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cursor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthorId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBook
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBook
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuthorId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CategoryIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBook
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBook
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CategoryIds = append(m.CategoryIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MatchAllCategories", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MatchAllCategories = bool(v != 0)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deleted", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBook
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBook
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deleted = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeDescendants", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeDescendants = bool(v != 0)
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBook
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBook
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ids = append(m.Ids, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBook(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBook
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListBookResp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBook
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListBookResp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListBookResp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Books", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBook
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBook
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Books = append(m.Books, &Book{})
+			if err := m.Books[len(m.Books)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1442,6 +2458,38 @@ func (m *ListBookResp) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextCursor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBook
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBook
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextCursor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBook(dAtA[iNdEx:])
@@ -5,6 +5,7 @@ package catalog
 
 import (
 	fmt "fmt"
+	types "github.com/gogo/protobuf/types"
 	proto "github.com/golang/protobuf/proto"
 	io "io"
 	math "math"
@@ -111,6 +112,7 @@ func (m *Category) GetDeletedAt() string {
 
 type GetCategoryByIdReq struct {
 	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	ExpectedUpdatedAt    string   `protobuf:"bytes,2,opt,name=expected_updated_at,json=expectedUpdatedAt,proto3" json:"expected_updated_at"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -156,9 +158,76 @@ func (m *GetCategoryByIdReq) GetId() string {
 	return ""
 }
 
+func (m *GetCategoryByIdReq) GetExpectedUpdatedAt() string {
+	if m != nil {
+		return m.ExpectedUpdatedAt
+	}
+	return ""
+}
+
+type PatchCategoryReq struct {
+	Category             *Category        `protobuf:"bytes,1,opt,name=category,proto3" json:"category"`
+	UpdateMask           *types.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *PatchCategoryReq) Reset()         { *m = PatchCategoryReq{} }
+func (m *PatchCategoryReq) String() string { return proto.CompactTextString(m) }
+func (*PatchCategoryReq) ProtoMessage()    {}
+func (*PatchCategoryReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a480602e0615d50, []int{2}
+}
+func (m *PatchCategoryReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PatchCategoryReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PatchCategoryReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PatchCategoryReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PatchCategoryReq.Merge(m, src)
+}
+func (m *PatchCategoryReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *PatchCategoryReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_PatchCategoryReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PatchCategoryReq proto.InternalMessageInfo
+
+func (m *PatchCategoryReq) GetCategory() *Category {
+	if m != nil {
+		return m.Category
+	}
+	return nil
+}
+
+func (m *PatchCategoryReq) GetUpdateMask() *types.FieldMask {
+	if m != nil {
+		return m.UpdateMask
+	}
+	return nil
+}
+
 type ListCategoryReq struct {
 	Page                 int64    `protobuf:"varint,1,opt,name=page,proto3" json:"page"`
 	Limit                int64    `protobuf:"varint,2,opt,name=limit,proto3" json:"limit"`
+	ParentId             string   `protobuf:"bytes,3,opt,name=parent_id,json=parentId,proto3" json:"parent_id"`
+	NamePrefix           string   `protobuf:"bytes,4,opt,name=name_prefix,json=namePrefix,proto3" json:"name_prefix"`
+	Cursor               string   `protobuf:"bytes,5,opt,name=cursor,proto3" json:"cursor"`
+	Deleted              string   `protobuf:"bytes,6,opt,name=deleted,proto3" json:"deleted"`
+	Ids                  []string `protobuf:"bytes,7,rep,name=ids,proto3" json:"ids"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -168,7 +237,7 @@ func (m *ListCategoryReq) Reset()         { *m = ListCategoryReq{} }
 func (m *ListCategoryReq) String() string { return proto.CompactTextString(m) }
 func (*ListCategoryReq) ProtoMessage()    {}
 func (*ListCategoryReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a480602e0615d50, []int{2}
+	return fileDescriptor_4a480602e0615d50, []int{3}
 }
 func (m *ListCategoryReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -211,9 +280,45 @@ func (m *ListCategoryReq) GetLimit() int64 {
 	return 0
 }
 
+func (m *ListCategoryReq) GetParentId() string {
+	if m != nil {
+		return m.ParentId
+	}
+	return ""
+}
+
+func (m *ListCategoryReq) GetNamePrefix() string {
+	if m != nil {
+		return m.NamePrefix
+	}
+	return ""
+}
+
+func (m *ListCategoryReq) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
+func (m *ListCategoryReq) GetDeleted() string {
+	if m != nil {
+		return m.Deleted
+	}
+	return ""
+}
+
+func (m *ListCategoryReq) GetIds() []string {
+	if m != nil {
+		return m.Ids
+	}
+	return nil
+}
+
 type ListCategoryResp struct {
 	Categories           []*Category `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories"`
 	Count                int64       `protobuf:"varint,2,opt,name=count,proto3" json:"count"`
+	NextCursor           string      `protobuf:"bytes,3,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor"`
 	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
 	XXX_unrecognized     []byte      `json:"-"`
 	XXX_sizecache        int32       `json:"-"`
@@ -223,7 +328,7 @@ func (m *ListCategoryResp) Reset()         { *m = ListCategoryResp{} }
 func (m *ListCategoryResp) String() string { return proto.CompactTextString(m) }
 func (*ListCategoryResp) ProtoMessage()    {}
 func (*ListCategoryResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a480602e0615d50, []int{3}
+	return fileDescriptor_4a480602e0615d50, []int{4}
 }
 func (m *ListCategoryResp) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -266,35 +371,185 @@ func (m *ListCategoryResp) GetCount() int64 {
 	return 0
 }
 
+func (m *ListCategoryResp) GetNextCursor() string {
+	if m != nil {
+		return m.NextCursor
+	}
+	return ""
+}
+
+type CategoryNode struct {
+	Id                   string          `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	Name                 string          `protobuf:"bytes,2,opt,name=name,proto3" json:"name"`
+	ParentId             string          `protobuf:"bytes,3,opt,name=parent_id,json=parentId,proto3" json:"parent_id"`
+	Children             []*CategoryNode `protobuf:"bytes,4,rep,name=children,proto3" json:"children"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *CategoryNode) Reset()         { *m = CategoryNode{} }
+func (m *CategoryNode) String() string { return proto.CompactTextString(m) }
+func (*CategoryNode) ProtoMessage()    {}
+func (*CategoryNode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a480602e0615d50, []int{5}
+}
+func (m *CategoryNode) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CategoryNode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CategoryNode.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CategoryNode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CategoryNode.Merge(m, src)
+}
+func (m *CategoryNode) XXX_Size() int {
+	return m.Size()
+}
+func (m *CategoryNode) XXX_DiscardUnknown() {
+	xxx_messageInfo_CategoryNode.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CategoryNode proto.InternalMessageInfo
+
+func (m *CategoryNode) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *CategoryNode) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CategoryNode) GetParentId() string {
+	if m != nil {
+		return m.ParentId
+	}
+	return ""
+}
+
+func (m *CategoryNode) GetChildren() []*CategoryNode {
+	if m != nil {
+		return m.Children
+	}
+	return nil
+}
+
+type CategoryTree struct {
+	Categories           []*CategoryNode `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories"`
+	Truncated            bool            `protobuf:"varint,2,opt,name=truncated,proto3" json:"truncated"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *CategoryTree) Reset()         { *m = CategoryTree{} }
+func (m *CategoryTree) String() string { return proto.CompactTextString(m) }
+func (*CategoryTree) ProtoMessage()    {}
+func (*CategoryTree) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a480602e0615d50, []int{6}
+}
+func (m *CategoryTree) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CategoryTree) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CategoryTree.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CategoryTree) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CategoryTree.Merge(m, src)
+}
+func (m *CategoryTree) XXX_Size() int {
+	return m.Size()
+}
+func (m *CategoryTree) XXX_DiscardUnknown() {
+	xxx_messageInfo_CategoryTree.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CategoryTree proto.InternalMessageInfo
+
+func (m *CategoryTree) GetCategories() []*CategoryNode {
+	if m != nil {
+		return m.Categories
+	}
+	return nil
+}
+
+func (m *CategoryTree) GetTruncated() bool {
+	if m != nil {
+		return m.Truncated
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*Category)(nil), "catalog.Category")
 	proto.RegisterType((*GetCategoryByIdReq)(nil), "catalog.GetCategoryByIdReq")
+	proto.RegisterType((*PatchCategoryReq)(nil), "catalog.PatchCategoryReq")
 	proto.RegisterType((*ListCategoryReq)(nil), "catalog.ListCategoryReq")
 	proto.RegisterType((*ListCategoryResp)(nil), "catalog.ListCategoryResp")
+	proto.RegisterType((*CategoryNode)(nil), "catalog.CategoryNode")
+	proto.RegisterType((*CategoryTree)(nil), "catalog.CategoryTree")
 }
 
 func init() { proto.RegisterFile("catalog_service/category.proto", fileDescriptor_4a480602e0615d50) }
 
 var fileDescriptor_4a480602e0615d50 = []byte{
-	// 287 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x91, 0xc1, 0x4a, 0xc3, 0x40,
-	0x10, 0x86, 0xdd, 0x26, 0xad, 0xcd, 0x08, 0x5a, 0x07, 0x0f, 0x01, 0x31, 0x94, 0xe0, 0xa1, 0xa7,
-	0x88, 0x7a, 0xf4, 0xd4, 0x7a, 0x90, 0x82, 0xa7, 0x5c, 0x3d, 0x84, 0x35, 0x3b, 0x84, 0x85, 0x34,
-	0x89, 0xc9, 0x54, 0xe8, 0x9b, 0xf8, 0x08, 0x3e, 0x8a, 0x47, 0x1f, 0x41, 0xe2, 0x8b, 0x48, 0x36,
-	0xdb, 0xa0, 0xde, 0x66, 0xfe, 0xef, 0xcb, 0x30, 0x99, 0x85, 0x20, 0x95, 0x2c, 0xf3, 0x32, 0x4b,
-	0x1a, 0xaa, 0x5f, 0x75, 0x4a, 0x57, 0xa9, 0x64, 0xca, 0xca, 0x7a, 0x17, 0x55, 0x75, 0xc9, 0x25,
-	0x1e, 0x5a, 0x1e, 0xbe, 0x0b, 0x98, 0xde, 0x5b, 0x86, 0xc7, 0x30, 0xd2, 0xca, 0x17, 0x73, 0xb1,
-	0xf0, 0xe2, 0x91, 0x56, 0x88, 0xe0, 0x16, 0x72, 0x43, 0xfe, 0xc8, 0x24, 0xa6, 0xc6, 0x73, 0xf0,
-	0x2a, 0x59, 0x53, 0xc1, 0x89, 0x56, 0xbe, 0x63, 0xc0, 0xb4, 0x0f, 0xd6, 0x0a, 0x2f, 0x00, 0xd2,
-	0x9a, 0x24, 0x93, 0x4a, 0x24, 0xfb, 0xae, 0xa1, 0x9e, 0x4d, 0x96, 0xdc, 0xe1, 0x6d, 0xa5, 0xf6,
-	0x78, 0xdc, 0x63, 0x9b, 0xf4, 0x58, 0x51, 0x4e, 0x16, 0x4f, 0x7a, 0x6c, 0x93, 0x25, 0x87, 0x97,
-	0x80, 0x0f, 0xc4, 0xfb, 0x65, 0x57, 0xbb, 0xb5, 0x8a, 0xe9, 0xe5, 0xff, 0xce, 0xe1, 0x1d, 0x9c,
-	0x3c, 0xea, 0x66, 0xd0, 0x3a, 0x05, 0xc1, 0xad, 0x64, 0x46, 0x46, 0x72, 0x62, 0x53, 0xe3, 0x19,
-	0x8c, 0x73, 0xbd, 0xd1, 0x6c, 0xfe, 0xcd, 0x89, 0xfb, 0x26, 0x7c, 0x82, 0xd9, 0xdf, 0x8f, 0x9b,
-	0x0a, 0xaf, 0x01, 0xec, 0xf1, 0x34, 0x35, 0xbe, 0x98, 0x3b, 0x8b, 0xa3, 0x9b, 0xd3, 0xc8, 0xde,
-	0x2f, 0x1a, 0xd4, 0x5f, 0x52, 0x37, 0x3c, 0x2d, 0xb7, 0xc5, 0x30, 0xdc, 0x34, 0xab, 0xd9, 0x47,
-	0x1b, 0x88, 0xcf, 0x36, 0x10, 0x5f, 0x6d, 0x20, 0xde, 0xbe, 0x83, 0x83, 0xe7, 0x89, 0x79, 0x8c,
-	0xdb, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x4e, 0x05, 0xf5, 0xa4, 0xae, 0x01, 0x00, 0x00,
+	// 511 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x52, 0xcd, 0x6e, 0xd3, 0x4c,
+	0x14, 0xfd, 0x1c, 0xa7, 0x49, 0x7c, 0xf3, 0x09, 0xd2, 0xe1, 0x47, 0x56, 0x01, 0x13, 0x79, 0xd5,
+	0x0d, 0x8e, 0x5a, 0xc4, 0x8a, 0x55, 0x5b, 0x09, 0x54, 0x09, 0x50, 0x65, 0x95, 0xb5, 0x35, 0x9d,
+	0xb9, 0x71, 0x47, 0x75, 0x6c, 0x33, 0x1e, 0xa3, 0x54, 0x42, 0x2c, 0x78, 0x0a, 0x1e, 0x81, 0x97,
+	0x60, 0xcf, 0x92, 0x47, 0x40, 0xe1, 0x45, 0xd0, 0xfc, 0x38, 0x0d, 0x6a, 0x61, 0xc3, 0x6e, 0xee,
+	0x39, 0xd7, 0xf7, 0x9e, 0x73, 0xae, 0x21, 0x62, 0x54, 0xd1, 0xa2, 0xca, 0xb3, 0x06, 0xe5, 0x7b,
+	0xc1, 0x70, 0xc6, 0xa8, 0xc2, 0xbc, 0x92, 0x97, 0x49, 0x2d, 0x2b, 0x55, 0x91, 0xa1, 0xe3, 0x77,
+	0xa6, 0x79, 0x55, 0xe5, 0x05, 0xce, 0x0c, 0x7c, 0xd6, 0xce, 0x67, 0x73, 0x81, 0x05, 0xcf, 0x16,
+	0xb4, 0xb9, 0xb0, 0xad, 0xf1, 0x17, 0x0f, 0x46, 0x47, 0xee, 0x6b, 0x72, 0x0b, 0x7a, 0x82, 0x87,
+	0xde, 0xd4, 0xdb, 0x0d, 0xd2, 0x9e, 0xe0, 0x84, 0x40, 0xbf, 0xa4, 0x0b, 0x0c, 0x7b, 0x06, 0x31,
+	0x6f, 0xf2, 0x00, 0x82, 0x9a, 0x4a, 0x2c, 0x55, 0x26, 0x78, 0xe8, 0x1b, 0x62, 0x64, 0x81, 0x63,
+	0x4e, 0x1e, 0x01, 0x30, 0x89, 0x54, 0x21, 0xcf, 0xa8, 0x0a, 0xfb, 0x86, 0x0d, 0x1c, 0x72, 0xa0,
+	0x34, 0xdd, 0xd6, 0xbc, 0xa3, 0xb7, 0x2c, 0xed, 0x10, 0x4b, 0x73, 0x2c, 0xd0, 0xd1, 0x03, 0x4b,
+	0x3b, 0xe4, 0x40, 0xc5, 0xa7, 0x40, 0x5e, 0xa2, 0xea, 0xc4, 0x1e, 0x5e, 0x1e, 0xf3, 0x14, 0xdf,
+	0x5d, 0xd3, 0x9c, 0xc0, 0x1d, 0x5c, 0xd6, 0xc8, 0xf4, 0x94, 0x8d, 0x65, 0xd6, 0xc2, 0x76, 0x47,
+	0xbd, 0xed, 0x96, 0xc6, 0x1f, 0x61, 0x72, 0x42, 0x15, 0x3b, 0xef, 0xe6, 0xea, 0x99, 0x4f, 0x60,
+	0xd4, 0x25, 0x6a, 0x26, 0x8f, 0xf7, 0xb7, 0x13, 0x17, 0x69, 0xb2, 0xee, 0x5b, 0xb7, 0x90, 0xe7,
+	0x30, 0xb6, 0x9b, 0x4c, 0xb0, 0x66, 0xd5, 0x78, 0x7f, 0x27, 0xb1, 0xd9, 0x27, 0x5d, 0xf6, 0xc9,
+	0x0b, 0x9d, 0xfd, 0x6b, 0xda, 0x5c, 0xa4, 0x2e, 0x05, 0xfd, 0x8e, 0xbf, 0x7a, 0x70, 0xfb, 0x95,
+	0x68, 0xd4, 0xe6, 0x7e, 0x02, 0xfd, 0x9a, 0xe6, 0x68, 0x76, 0xfb, 0xa9, 0x79, 0x93, 0xbb, 0xb0,
+	0x55, 0x88, 0x85, 0xb0, 0x4e, 0xfc, 0xd4, 0x16, 0x7f, 0xbf, 0xc6, 0x63, 0x18, 0xeb, 0x93, 0x65,
+	0xb5, 0xc4, 0xb9, 0x58, 0xba, 0x73, 0x80, 0x86, 0x4e, 0x0c, 0x42, 0xee, 0xc3, 0x80, 0xb5, 0xb2,
+	0xa9, 0xa4, 0xbb, 0x85, 0xab, 0x48, 0x08, 0x43, 0x17, 0xbb, 0xbb, 0x42, 0x57, 0x92, 0x09, 0xf8,
+	0x82, 0x37, 0xe1, 0x70, 0xea, 0xef, 0x06, 0xa9, 0x7e, 0xc6, 0x1f, 0x60, 0xf2, 0xbb, 0xfc, 0xa6,
+	0x26, 0x7b, 0x00, 0x2e, 0x1c, 0x81, 0x4d, 0xe8, 0x4d, 0xfd, 0x9b, 0x13, 0xdc, 0x68, 0xd2, 0xf6,
+	0x58, 0xd5, 0x96, 0x6b, 0x7b, 0xa6, 0x30, 0x0e, 0x70, 0xa9, 0x32, 0xa7, 0xd2, 0x77, 0x0e, 0x70,
+	0xa9, 0x8e, 0x0c, 0x12, 0x7f, 0xf2, 0xe0, 0xff, 0x6e, 0xde, 0x9b, 0x8a, 0xe3, 0xbf, 0xff, 0xc2,
+	0x7b, 0x30, 0x62, 0xe7, 0xa2, 0xe0, 0x12, 0xcb, 0xb0, 0x6f, 0x94, 0xdf, 0xbb, 0xa6, 0x5c, 0x6f,
+	0x4a, 0xd7, 0x6d, 0x31, 0xbb, 0xd2, 0x70, 0x2a, 0x11, 0xc9, 0xb3, 0x1b, 0xec, 0xff, 0x61, 0xc8,
+	0x66, 0x04, 0x0f, 0x21, 0x50, 0xb2, 0x2d, 0x35, 0xc2, 0x8d, 0xde, 0x51, 0x7a, 0x05, 0x1c, 0x4e,
+	0xbe, 0xad, 0x22, 0xef, 0xfb, 0x2a, 0xf2, 0x7e, 0xac, 0x22, 0xef, 0xf3, 0xcf, 0xe8, 0xbf, 0xb3,
+	0x81, 0xf9, 0xb3, 0x9e, 0xfe, 0x1a, 0x00, 0x90, 0x4f, 0x62, 0x46, 0x0e, 0x04, 0x00, 0x00,
 }
 
 func (m *Category) Marshal() (dAtA []byte, err error) {
@@ -390,6 +645,13 @@ func (m *GetCategoryByIdReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.ExpectedUpdatedAt) > 0 {
+		i -= len(m.ExpectedUpdatedAt)
+		copy(dAtA[i:], m.ExpectedUpdatedAt)
+		i = encodeVarintCategory(dAtA, i, uint64(len(m.ExpectedUpdatedAt)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Id) > 0 {
 		i -= len(m.Id)
 		copy(dAtA[i:], m.Id)
@@ -400,6 +662,57 @@ func (m *GetCategoryByIdReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PatchCategoryReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PatchCategoryReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PatchCategoryReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.UpdateMask != nil {
+		{
+			size, err := m.UpdateMask.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCategory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Category != nil {
+		{
+			size, err := m.Category.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCategory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ListCategoryReq) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -424,6 +737,43 @@ func (m *ListCategoryReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Ids) > 0 {
+		for iNdEx := len(m.Ids) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Ids[iNdEx])
+			copy(dAtA[i:], m.Ids[iNdEx])
+			i = encodeVarintCategory(dAtA, i, uint64(len(m.Ids[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Deleted) > 0 {
+		i -= len(m.Deleted)
+		copy(dAtA[i:], m.Deleted)
+		i = encodeVarintCategory(dAtA, i, uint64(len(m.Deleted)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Cursor) > 0 {
+		i -= len(m.Cursor)
+		copy(dAtA[i:], m.Cursor)
+		i = encodeVarintCategory(dAtA, i, uint64(len(m.Cursor)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.NamePrefix) > 0 {
+		i -= len(m.NamePrefix)
+		copy(dAtA[i:], m.NamePrefix)
+		i = encodeVarintCategory(dAtA, i, uint64(len(m.NamePrefix)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ParentId) > 0 {
+		i -= len(m.ParentId)
+		copy(dAtA[i:], m.ParentId)
+		i = encodeVarintCategory(dAtA, i, uint64(len(m.ParentId)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.Limit != 0 {
 		i = encodeVarintCategory(dAtA, i, uint64(m.Limit))
 		i--
@@ -461,6 +811,13 @@ func (m *ListCategoryResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.NextCursor) > 0 {
+		i -= len(m.NextCursor)
+		copy(dAtA[i:], m.NextCursor)
+		i = encodeVarintCategory(dAtA, i, uint64(len(m.NextCursor)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.Count != 0 {
 		i = encodeVarintCategory(dAtA, i, uint64(m.Count))
 		i--
@@ -483,34 +840,147 @@ func (m *ListCategoryResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintCategory(dAtA []byte, offset int, v uint64) int {
-	offset -= sovCategory(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *CategoryNode) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Category) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *CategoryNode) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CategoryNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovCategory(uint64(l))
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovCategory(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.ParentId)
-	if l > 0 {
-		n += 1 + l + sovCategory(uint64(l))
+	if len(m.Children) > 0 {
+		for iNdEx := len(m.Children) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Children[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCategory(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ParentId) > 0 {
+		i -= len(m.ParentId)
+		copy(dAtA[i:], m.ParentId)
+		i = encodeVarintCategory(dAtA, i, uint64(len(m.ParentId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintCategory(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintCategory(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CategoryTree) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CategoryTree) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CategoryTree) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Truncated {
+		i--
+		if m.Truncated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Categories) > 0 {
+		for iNdEx := len(m.Categories) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Categories[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCategory(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintCategory(dAtA []byte, offset int, v uint64) int {
+	offset -= sovCategory(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Category) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovCategory(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovCategory(uint64(l))
+	}
+	l = len(m.ParentId)
+	if l > 0 {
+		n += 1 + l + sovCategory(uint64(l))
 	}
 	l = len(m.CreatedAt)
 	if l > 0 {
@@ -540,6 +1010,30 @@ func (m *GetCategoryByIdReq) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovCategory(uint64(l))
 	}
+	l = len(m.ExpectedUpdatedAt)
+	if l > 0 {
+		n += 1 + l + sovCategory(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PatchCategoryReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Category != nil {
+		l = m.Category.Size()
+		n += 1 + l + sovCategory(uint64(l))
+	}
+	if m.UpdateMask != nil {
+		l = m.UpdateMask.Size()
+		n += 1 + l + sovCategory(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -558,6 +1052,28 @@ func (m *ListCategoryReq) Size() (n int) {
 	if m.Limit != 0 {
 		n += 1 + sovCategory(uint64(m.Limit))
 	}
+	l = len(m.ParentId)
+	if l > 0 {
+		n += 1 + l + sovCategory(uint64(l))
+	}
+	l = len(m.NamePrefix)
+	if l > 0 {
+		n += 1 + l + sovCategory(uint64(l))
+	}
+	l = len(m.Cursor)
+	if l > 0 {
+		n += 1 + l + sovCategory(uint64(l))
+	}
+	l = len(m.Deleted)
+	if l > 0 {
+		n += 1 + l + sovCategory(uint64(l))
+	}
+	if len(m.Ids) > 0 {
+		for _, s := range m.Ids {
+			l = len(s)
+			n += 1 + l + sovCategory(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -576,22 +1092,558 @@ func (m *ListCategoryResp) Size() (n int) {
 			n += 1 + l + sovCategory(uint64(l))
 		}
 	}
-	if m.Count != 0 {
-		n += 1 + sovCategory(uint64(m.Count))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
+	if m.Count != 0 {
+		n += 1 + sovCategory(uint64(m.Count))
+	}
+	l = len(m.NextCursor)
+	if l > 0 {
+		n += 1 + l + sovCategory(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CategoryNode) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovCategory(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovCategory(uint64(l))
+	}
+	l = len(m.ParentId)
+	if l > 0 {
+		n += 1 + l + sovCategory(uint64(l))
+	}
+	if len(m.Children) > 0 {
+		for _, e := range m.Children {
+			l = e.Size()
+			n += 1 + l + sovCategory(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CategoryTree) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Categories) > 0 {
+		for _, e := range m.Categories {
+			l = e.Size()
+			n += 1 + l + sovCategory(uint64(l))
+		}
+	}
+	if m.Truncated {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovCategory(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozCategory(x uint64) (n int) {
+	return sovCategory(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Category) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCategory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Category: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Category: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCategory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCategory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCategory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCategory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCategory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCategory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCategory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCategory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCategory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParentId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCategory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCategory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCategory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCategory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCategory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCategory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeletedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCategory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCategory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCategory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeletedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCategory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCategory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetCategoryByIdReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCategory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetCategoryByIdReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetCategoryByIdReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCategory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCategory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCategory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedUpdatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCategory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCategory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCategory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpectedUpdatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCategory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCategory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PatchCategoryReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCategory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PatchCategoryReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PatchCategoryReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Category", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCategory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCategory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCategory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Category == nil {
+				m.Category = &Category{}
+			}
+			if err := m.Category.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateMask", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCategory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCategory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCategory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UpdateMask == nil {
+				m.UpdateMask = &types.FieldMask{}
+			}
+			if err := m.UpdateMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCategory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCategory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
 
-func sovCategory(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozCategory(x uint64) (n int) {
-	return sovCategory(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Category) Unmarshal(dAtA []byte) error {
+func (m *ListCategoryReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -614,17 +1666,17 @@ func (m *Category) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Category: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListCategoryReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Category: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListCategoryReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
 			}
-			var stringLen uint64
+			m.Page = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCategory
@@ -634,27 +1686,33 @@ func (m *Category) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Page |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthCategory
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthCategory
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCategory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -682,11 +1740,11 @@ func (m *Category) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.ParentId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParentId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NamePrefix", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -714,11 +1772,11 @@ func (m *Category) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ParentId = string(dAtA[iNdEx:postIndex])
+			m.NamePrefix = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cursor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -746,11 +1804,11 @@ func (m *Category) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CreatedAt = string(dAtA[iNdEx:postIndex])
+			m.Cursor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Deleted", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -778,11 +1836,11 @@ func (m *Category) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UpdatedAt = string(dAtA[iNdEx:postIndex])
+			m.Deleted = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeletedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -810,7 +1868,7 @@ func (m *Category) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DeletedAt = string(dAtA[iNdEx:postIndex])
+			m.Ids = append(m.Ids, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -834,7 +1892,7 @@ func (m *Category) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetCategoryByIdReq) Unmarshal(dAtA []byte) error {
+func (m *ListCategoryResp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -857,15 +1915,68 @@ func (m *GetCategoryByIdReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetCategoryByIdReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListCategoryResp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetCategoryByIdReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListCategoryResp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Categories", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCategory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCategory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCategory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Categories = append(m.Categories, &Category{})
+			if err := m.Categories[len(m.Categories)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCategory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextCursor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -893,7 +2004,7 @@ func (m *GetCategoryByIdReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.NextCursor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -917,7 +2028,7 @@ func (m *GetCategoryByIdReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListCategoryReq) Unmarshal(dAtA []byte) error {
+func (m *CategoryNode) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -940,17 +2051,17 @@ func (m *ListCategoryReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListCategoryReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: CategoryNode: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListCategoryReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CategoryNode: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			m.Page = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCategory
@@ -960,16 +2071,29 @@ func (m *ListCategoryReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Page |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCategory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCategory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			m.Limit = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCategory
@@ -979,11 +2103,90 @@ func (m *ListCategoryReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Limit |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCategory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCategory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCategory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCategory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCategory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParentId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Children", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCategory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthCategory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCategory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Children = append(m.Children, &CategoryNode{})
+			if err := m.Children[len(m.Children)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCategory(dAtA[iNdEx:])
@@ -1006,7 +2209,7 @@ func (m *ListCategoryReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListCategoryResp) Unmarshal(dAtA []byte) error {
+func (m *CategoryTree) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1029,10 +2232,10 @@ func (m *ListCategoryResp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListCategoryResp: wiretype end group for non-group")
+			return fmt.Errorf("proto: CategoryTree: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListCategoryResp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CategoryTree: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1064,16 +2267,16 @@ func (m *ListCategoryResp) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Categories = append(m.Categories, &Category{})
+			m.Categories = append(m.Categories, &CategoryNode{})
 			if err := m.Categories[len(m.Categories)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Truncated", wireType)
 			}
-			m.Count = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCategory
@@ -1083,11 +2286,12 @@ func (m *ListCategoryResp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Count |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Truncated = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCategory(dAtA[iNdEx:])
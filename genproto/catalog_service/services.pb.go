@@ -27,31 +27,36 @@ const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 func init() { proto.RegisterFile("catalog_service/services.proto", fileDescriptor_4b4fb7c4077dedf6) }
 
 var fileDescriptor_4b4fb7c4077dedf6 = []byte{
-	// 372 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xcd, 0x4e, 0xc2, 0x40,
-	0x10, 0x86, 0x8b, 0xc4, 0x41, 0x7e, 0x9c, 0xa8, 0x48, 0x35, 0x3d, 0x70, 0x36, 0x98, 0xe0, 0x41,
-	0x63, 0x62, 0x82, 0x54, 0xd3, 0x98, 0x78, 0xc2, 0x78, 0x36, 0x05, 0x26, 0x48, 0xc0, 0x6c, 0xed,
-	0xae, 0x26, 0xbc, 0x89, 0x07, 0x1f, 0xc8, 0xa3, 0x8f, 0x60, 0xf0, 0x45, 0xcc, 0xee, 0xb4, 0xb5,
-	0xc0, 0x1a, 0xe1, 0xd4, 0xcc, 0xf7, 0xd7, 0x99, 0x2f, 0x2d, 0xb8, 0xfd, 0x40, 0x05, 0x13, 0x31,
-	0x7c, 0x90, 0x14, 0xbd, 0x8e, 0xfa, 0x74, 0x1c, 0x3f, 0x65, 0x33, 0x8c, 0x84, 0x12, 0x58, 0x88,
-	0x79, 0xc7, 0x59, 0x14, 0xf6, 0x84, 0x18, 0xb3, 0xc8, 0x39, 0x5c, 0xe4, 0x82, 0x17, 0xf5, 0x28,
-	0xa2, 0x98, 0x5d, 0x7a, 0x45, 0x3f, 0x50, 0x34, 0x14, 0xd1, 0x94, 0xf9, 0xd6, 0x7b, 0x01, 0xca,
-	0x1e, 0x4b, 0xee, 0x58, 0x81, 0x47, 0x00, 0x5e, 0x44, 0x81, 0xa2, 0x8e, 0x10, 0x63, 0x2c, 0x35,
-	0xe3, 0x84, 0xa6, 0x1e, 0x9d, 0xf9, 0xb1, 0x91, 0xd3, 0xea, 0xfb, 0x70, 0xb0, 0xaa, 0xfa, 0x14,
-	0x8a, 0x3e, 0x29, 0x3d, 0x74, 0xa6, 0x37, 0x03, 0xac, 0xa5, 0x7c, 0x06, 0xed, 0xd2, 0xf3, 0xb2,
-	0xb1, 0x0d, 0x95, 0x2b, 0x9a, 0x90, 0xa2, 0xc1, 0xff, 0x66, 0x4c, 0x89, 0xeb, 0xa7, 0x50, 0x4d,
-	0xbb, 0x24, 0xc3, 0x46, 0x0e, 0xcf, 0x61, 0xf3, 0x76, 0x24, 0x8d, 0x50, 0xe2, 0x4e, 0x2a, 0x49,
-	0x30, 0x6d, 0xdc, 0xb5, 0xa0, 0xc6, 0xdb, 0x82, 0x2d, 0xae, 0xe4, 0xd2, 0x74, 0x8b, 0x95, 0x54,
-	0xc8, 0x80, 0xb3, 0x08, 0xb0, 0x87, 0x8b, 0x59, 0xc3, 0x73, 0x01, 0x25, 0x9f, 0x14, 0x8f, 0xe6,
-	0xc6, 0x7a, 0xf6, 0xc6, 0x5f, 0x5c, 0x2f, 0x6b, 0xb1, 0x7b, 0x50, 0xe5, 0x92, 0x56, 0x4b, 0xb0,
-	0xf7, 0xd4, 0x86, 0xa2, 0xbe, 0x9e, 0xa5, 0x12, 0xf7, 0xe6, 0x3a, 0x61, 0x54, 0x9b, 0x6b, 0x56,
-	0xdc, 0x24, 0x9c, 0x41, 0x99, 0xdb, 0xf2, 0xe2, 0x6f, 0x0d, 0xb7, 0x53, 0x71, 0x02, 0x39, 0xcb,
-	0x10, 0x3b, 0xb9, 0xb3, 0xb5, 0x9d, 0x1e, 0x54, 0x7c, 0x52, 0x09, 0x60, 0x2e, 0x3f, 0xc8, 0x5e,
-	0x9e, 0x65, 0xf4, 0xfa, 0xd6, 0x10, 0x1f, 0x90, 0xfb, 0x5b, 0x3d, 0xc7, 0xde, 0xa1, 0x0f, 0x65,
-	0xdd, 0x4a, 0x2c, 0x1e, 0x91, 0xc4, 0xfd, 0xb9, 0xba, 0x92, 0x14, 0x9d, 0x50, 0xff, 0x83, 0xd1,
-	0x41, 0x9d, 0xea, 0xc7, 0xcc, 0xcd, 0x7f, 0xce, 0xdc, 0xfc, 0xd7, 0xcc, 0xcd, 0xbf, 0x7d, 0xbb,
-	0xb9, 0xde, 0x86, 0xf9, 0x6f, 0x4f, 0x7e, 0x02, 0x00, 0x00, 0xff, 0xff, 0xa2, 0x34, 0x1d, 0x92,
-	0x3c, 0x04, 0x00, 0x00,
+	// 464 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xcf, 0x6e, 0xda, 0x40,
+	0x10, 0xc6, 0xcb, 0xa5, 0x15, 0xe3, 0x1a, 0xd3, 0x55, 0x29, 0xc5, 0xad, 0xfc, 0x00, 0x3d, 0xd0,
+	0x7f, 0x6a, 0x8b, 0x44, 0x83, 0x62, 0x08, 0x42, 0x91, 0x72, 0x40, 0x44, 0x39, 0x47, 0xc6, 0xac,
+	0x00, 0x41, 0xb4, 0x8e, 0x77, 0x89, 0xc4, 0x9b, 0x44, 0x79, 0xa2, 0x1c, 0xf3, 0x08, 0x11, 0x79,
+	0x91, 0x68, 0xff, 0x78, 0xb1, 0xcd, 0x22, 0xcc, 0x29, 0x99, 0x6f, 0xbe, 0x6f, 0x66, 0x99, 0x9f,
+	0x00, 0xbc, 0x30, 0x60, 0xc1, 0x92, 0x4c, 0xaf, 0x29, 0x8e, 0xef, 0xe6, 0x21, 0xfe, 0xae, 0xfe,
+	0xd2, 0x66, 0x14, 0x13, 0x46, 0xd0, 0x3b, 0xd5, 0x77, 0xdd, 0xbc, 0x71, 0x4c, 0xc8, 0x42, 0x9a,
+	0xdc, 0xaf, 0xf9, 0x5e, 0xb0, 0x62, 0x33, 0x12, 0xab, 0xee, 0xce, 0x8a, 0x30, 0x60, 0x78, 0x4a,
+	0xe2, 0xb5, 0xec, 0xff, 0x7a, 0xb0, 0xa0, 0xd2, 0x93, 0x96, 0x4b, 0xe9, 0x40, 0xdf, 0x00, 0x7a,
+	0x31, 0x0e, 0x18, 0xee, 0x12, 0xb2, 0x40, 0x76, 0x53, 0x4d, 0x68, 0xf2, 0xd2, 0xcd, 0x96, 0xdc,
+	0x7b, 0x15, 0x4d, 0x8a, 0x79, 0x7f, 0x42, 0x79, 0x18, 0xb0, 0x70, 0x26, 0x8a, 0x9a, 0xee, 0x69,
+	0x6d, 0x84, 0x6f, 0xf3, 0x91, 0x3f, 0x60, 0x0d, 0x30, 0xe3, 0xff, 0x76, 0xd7, 0xe7, 0x13, 0x54,
+	0xd7, 0xdd, 0x94, 0x6a, 0x88, 0x75, 0xc0, 0x39, 0xc3, 0x4b, 0xcc, 0xf0, 0xe4, 0x70, 0x14, 0xe9,
+	0x46, 0xff, 0x26, 0x62, 0xeb, 0x11, 0xa6, 0x11, 0x5f, 0x3b, 0xc2, 0x94, 0x91, 0x58, 0x7e, 0xac,
+	0xa2, 0x6b, 0x5b, 0x50, 0x1e, 0xae, 0xe2, 0xe9, 0x81, 0x90, 0x69, 0x61, 0x0b, 0xca, 0x17, 0x73,
+	0x2a, 0x6c, 0x14, 0x7d, 0xd4, 0x86, 0x44, 0xe3, 0xb1, 0x9a, 0x41, 0xa5, 0x11, 0xfa, 0x01, 0xef,
+	0x25, 0x2c, 0x5f, 0x50, 0x47, 0x8e, 0xb6, 0x49, 0xc1, 0xcd, 0x0b, 0x3c, 0x21, 0x91, 0x15, 0x4e,
+	0xfc, 0x03, 0x4b, 0x40, 0x52, 0x65, 0x3d, 0x8b, 0x4e, 0xaa, 0xfc, 0x89, 0x3b, 0xc1, 0x36, 0xd8,
+	0x03, 0xcc, 0x64, 0x21, 0x28, 0x34, 0xd2, 0x47, 0xd9, 0xea, 0xc6, 0xb0, 0x0f, 0x55, 0x09, 0xb1,
+	0x58, 0xde, 0x74, 0xd6, 0x36, 0xd8, 0x8a, 0xa3, 0x9a, 0x79, 0xcc, 0xfe, 0xff, 0x60, 0x09, 0x9a,
+	0x87, 0xa3, 0xa6, 0xd5, 0x1d, 0xb0, 0x38, 0x27, 0x69, 0xa4, 0xe8, 0x53, 0x86, 0xde, 0xf6, 0x64,
+	0x75, 0xa3, 0x4e, 0x23, 0xf4, 0x17, 0x2a, 0x92, 0x6b, 0x4f, 0x7d, 0x5f, 0xd1, 0x07, 0x6d, 0x4d,
+	0x24, 0x77, 0x57, 0xe2, 0x39, 0x49, 0xf7, 0xc8, 0xdc, 0x09, 0xd8, 0x82, 0xa6, 0x16, 0x1a, 0x59,
+	0xca, 0x89, 0xce, 0x1f, 0x6d, 0x88, 0xfb, 0xe0, 0x0c, 0x30, 0x4b, 0x4a, 0xc1, 0xea, 0x4b, 0xfa,
+	0x60, 0xe9, 0xce, 0x9e, 0x11, 0x7d, 0x40, 0x92, 0x77, 0xf1, 0x29, 0xa6, 0xc3, 0xfb, 0xe0, 0x28,
+	0xe6, 0x7a, 0xf2, 0xb1, 0x2f, 0x39, 0x05, 0x5b, 0x90, 0x2f, 0x36, 0xc0, 0xf4, 0x88, 0x3e, 0x54,
+	0x38, 0x4f, 0x65, 0x9d, 0x63, 0x8a, 0x3e, 0x67, 0x40, 0xa7, 0xaf, 0xd9, 0xd8, 0xd3, 0xa1, 0x51,
+	0xb7, 0xfa, 0xb8, 0xf1, 0x4a, 0x4f, 0x1b, 0xaf, 0xf4, 0xbc, 0xf1, 0x4a, 0xf7, 0x2f, 0xde, 0x9b,
+	0xf1, 0x5b, 0xf1, 0xab, 0xfd, 0xfb, 0x75, 0x00, 0x10, 0x3b, 0x47, 0x49, 0x3a, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -66,23 +71,29 @@ const _ = grpc.SupportPackageIsVersion4
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type CatalogServiceClient interface {
-	// CRUD for Books
 	CreateBook(ctx context.Context, in *Book, opts ...grpc.CallOption) (*Book, error)
 	UpdateBook(ctx context.Context, in *Book, opts ...grpc.CallOption) (*Book, error)
+	PatchBook(ctx context.Context, in *PatchBookReq, opts ...grpc.CallOption) (*Book, error)
 	GetBookById(ctx context.Context, in *GetBookByIdReq, opts ...grpc.CallOption) (*Book, error)
 	DeletedBookById(ctx context.Context, in *GetBookByIdReq, opts ...grpc.CallOption) (*EmptyResp, error)
+	RestoreBook(ctx context.Context, in *GetBookByIdReq, opts ...grpc.CallOption) (*Book, error)
+	PurgeBook(ctx context.Context, in *GetBookByIdReq, opts ...grpc.CallOption) (*EmptyResp, error)
 	ListBooks(ctx context.Context, in *ListBookReq, opts ...grpc.CallOption) (*ListBookResp, error)
-	// CRUD for Authors
 	CreateAuthor(ctx context.Context, in *Author, opts ...grpc.CallOption) (*Author, error)
 	UpdateAuthor(ctx context.Context, in *Author, opts ...grpc.CallOption) (*Author, error)
+	PatchAuthor(ctx context.Context, in *PatchAuthorReq, opts ...grpc.CallOption) (*Author, error)
 	GetAuthorById(ctx context.Context, in *GetAuthorByIdReq, opts ...grpc.CallOption) (*Author, error)
 	DeleteAuthorById(ctx context.Context, in *GetAuthorByIdReq, opts ...grpc.CallOption) (*EmptyResp, error)
+	RestoreAuthor(ctx context.Context, in *GetAuthorByIdReq, opts ...grpc.CallOption) (*Author, error)
+	PurgeAuthor(ctx context.Context, in *GetAuthorByIdReq, opts ...grpc.CallOption) (*EmptyResp, error)
 	ListAuthors(ctx context.Context, in *ListAuthorReq, opts ...grpc.CallOption) (*ListAuthorResp, error)
-	// CRUD for Categories
 	CreateCategory(ctx context.Context, in *Category, opts ...grpc.CallOption) (*Category, error)
 	UpdateCategory(ctx context.Context, in *Category, opts ...grpc.CallOption) (*Category, error)
+	PatchCategory(ctx context.Context, in *PatchCategoryReq, opts ...grpc.CallOption) (*Category, error)
 	GetCategoryById(ctx context.Context, in *GetCategoryByIdReq, opts ...grpc.CallOption) (*Category, error)
 	DeleteCategoryById(ctx context.Context, in *GetCategoryByIdReq, opts ...grpc.CallOption) (*EmptyResp, error)
+	RestoreCategory(ctx context.Context, in *GetCategoryByIdReq, opts ...grpc.CallOption) (*Category, error)
+	PurgeCategory(ctx context.Context, in *GetCategoryByIdReq, opts ...grpc.CallOption) (*EmptyResp, error)
 	ListCategories(ctx context.Context, in *ListCategoryReq, opts ...grpc.CallOption) (*ListCategoryResp, error)
 }
 
@@ -112,6 +123,15 @@ func (c *catalogServiceClient) UpdateBook(ctx context.Context, in *Book, opts ..
 	return out, nil
 }
 
+func (c *catalogServiceClient) PatchBook(ctx context.Context, in *PatchBookReq, opts ...grpc.CallOption) (*Book, error) {
+	out := new(Book)
+	err := c.cc.Invoke(ctx, "/catalog.CatalogService/PatchBook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *catalogServiceClient) GetBookById(ctx context.Context, in *GetBookByIdReq, opts ...grpc.CallOption) (*Book, error) {
 	out := new(Book)
 	err := c.cc.Invoke(ctx, "/catalog.CatalogService/GetBookById", in, out, opts...)
@@ -130,6 +150,24 @@ func (c *catalogServiceClient) DeletedBookById(ctx context.Context, in *GetBookB
 	return out, nil
 }
 
+func (c *catalogServiceClient) RestoreBook(ctx context.Context, in *GetBookByIdReq, opts ...grpc.CallOption) (*Book, error) {
+	out := new(Book)
+	err := c.cc.Invoke(ctx, "/catalog.CatalogService/RestoreBook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) PurgeBook(ctx context.Context, in *GetBookByIdReq, opts ...grpc.CallOption) (*EmptyResp, error) {
+	out := new(EmptyResp)
+	err := c.cc.Invoke(ctx, "/catalog.CatalogService/PurgeBook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *catalogServiceClient) ListBooks(ctx context.Context, in *ListBookReq, opts ...grpc.CallOption) (*ListBookResp, error) {
 	out := new(ListBookResp)
 	err := c.cc.Invoke(ctx, "/catalog.CatalogService/ListBooks", in, out, opts...)
@@ -157,6 +195,15 @@ func (c *catalogServiceClient) UpdateAuthor(ctx context.Context, in *Author, opt
 	return out, nil
 }
 
+func (c *catalogServiceClient) PatchAuthor(ctx context.Context, in *PatchAuthorReq, opts ...grpc.CallOption) (*Author, error) {
+	out := new(Author)
+	err := c.cc.Invoke(ctx, "/catalog.CatalogService/PatchAuthor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *catalogServiceClient) GetAuthorById(ctx context.Context, in *GetAuthorByIdReq, opts ...grpc.CallOption) (*Author, error) {
 	out := new(Author)
 	err := c.cc.Invoke(ctx, "/catalog.CatalogService/GetAuthorById", in, out, opts...)
@@ -175,6 +222,24 @@ func (c *catalogServiceClient) DeleteAuthorById(ctx context.Context, in *GetAuth
 	return out, nil
 }
 
+func (c *catalogServiceClient) RestoreAuthor(ctx context.Context, in *GetAuthorByIdReq, opts ...grpc.CallOption) (*Author, error) {
+	out := new(Author)
+	err := c.cc.Invoke(ctx, "/catalog.CatalogService/RestoreAuthor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) PurgeAuthor(ctx context.Context, in *GetAuthorByIdReq, opts ...grpc.CallOption) (*EmptyResp, error) {
+	out := new(EmptyResp)
+	err := c.cc.Invoke(ctx, "/catalog.CatalogService/PurgeAuthor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *catalogServiceClient) ListAuthors(ctx context.Context, in *ListAuthorReq, opts ...grpc.CallOption) (*ListAuthorResp, error) {
 	out := new(ListAuthorResp)
 	err := c.cc.Invoke(ctx, "/catalog.CatalogService/ListAuthors", in, out, opts...)
@@ -202,6 +267,15 @@ func (c *catalogServiceClient) UpdateCategory(ctx context.Context, in *Category,
 	return out, nil
 }
 
+func (c *catalogServiceClient) PatchCategory(ctx context.Context, in *PatchCategoryReq, opts ...grpc.CallOption) (*Category, error) {
+	out := new(Category)
+	err := c.cc.Invoke(ctx, "/catalog.CatalogService/PatchCategory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *catalogServiceClient) GetCategoryById(ctx context.Context, in *GetCategoryByIdReq, opts ...grpc.CallOption) (*Category, error) {
 	out := new(Category)
 	err := c.cc.Invoke(ctx, "/catalog.CatalogService/GetCategoryById", in, out, opts...)
@@ -220,6 +294,24 @@ func (c *catalogServiceClient) DeleteCategoryById(ctx context.Context, in *GetCa
 	return out, nil
 }
 
+func (c *catalogServiceClient) RestoreCategory(ctx context.Context, in *GetCategoryByIdReq, opts ...grpc.CallOption) (*Category, error) {
+	out := new(Category)
+	err := c.cc.Invoke(ctx, "/catalog.CatalogService/RestoreCategory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) PurgeCategory(ctx context.Context, in *GetCategoryByIdReq, opts ...grpc.CallOption) (*EmptyResp, error) {
+	out := new(EmptyResp)
+	err := c.cc.Invoke(ctx, "/catalog.CatalogService/PurgeCategory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *catalogServiceClient) ListCategories(ctx context.Context, in *ListCategoryReq, opts ...grpc.CallOption) (*ListCategoryResp, error) {
 	out := new(ListCategoryResp)
 	err := c.cc.Invoke(ctx, "/catalog.CatalogService/ListCategories", in, out, opts...)
@@ -231,23 +323,29 @@ func (c *catalogServiceClient) ListCategories(ctx context.Context, in *ListCateg
 
 // CatalogServiceServer is the server API for CatalogService service.
 type CatalogServiceServer interface {
-	// CRUD for Books
 	CreateBook(context.Context, *Book) (*Book, error)
 	UpdateBook(context.Context, *Book) (*Book, error)
+	PatchBook(context.Context, *PatchBookReq) (*Book, error)
 	GetBookById(context.Context, *GetBookByIdReq) (*Book, error)
 	DeletedBookById(context.Context, *GetBookByIdReq) (*EmptyResp, error)
+	RestoreBook(context.Context, *GetBookByIdReq) (*Book, error)
+	PurgeBook(context.Context, *GetBookByIdReq) (*EmptyResp, error)
 	ListBooks(context.Context, *ListBookReq) (*ListBookResp, error)
-	// CRUD for Authors
 	CreateAuthor(context.Context, *Author) (*Author, error)
 	UpdateAuthor(context.Context, *Author) (*Author, error)
+	PatchAuthor(context.Context, *PatchAuthorReq) (*Author, error)
 	GetAuthorById(context.Context, *GetAuthorByIdReq) (*Author, error)
 	DeleteAuthorById(context.Context, *GetAuthorByIdReq) (*EmptyResp, error)
+	RestoreAuthor(context.Context, *GetAuthorByIdReq) (*Author, error)
+	PurgeAuthor(context.Context, *GetAuthorByIdReq) (*EmptyResp, error)
 	ListAuthors(context.Context, *ListAuthorReq) (*ListAuthorResp, error)
-	// CRUD for Categories
 	CreateCategory(context.Context, *Category) (*Category, error)
 	UpdateCategory(context.Context, *Category) (*Category, error)
+	PatchCategory(context.Context, *PatchCategoryReq) (*Category, error)
 	GetCategoryById(context.Context, *GetCategoryByIdReq) (*Category, error)
 	DeleteCategoryById(context.Context, *GetCategoryByIdReq) (*EmptyResp, error)
+	RestoreCategory(context.Context, *GetCategoryByIdReq) (*Category, error)
+	PurgeCategory(context.Context, *GetCategoryByIdReq) (*EmptyResp, error)
 	ListCategories(context.Context, *ListCategoryReq) (*ListCategoryResp, error)
 }
 
@@ -261,12 +359,21 @@ func (*UnimplementedCatalogServiceServer) CreateBook(ctx context.Context, req *B
 func (*UnimplementedCatalogServiceServer) UpdateBook(ctx context.Context, req *Book) (*Book, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateBook not implemented")
 }
+func (*UnimplementedCatalogServiceServer) PatchBook(ctx context.Context, req *PatchBookReq) (*Book, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PatchBook not implemented")
+}
 func (*UnimplementedCatalogServiceServer) GetBookById(ctx context.Context, req *GetBookByIdReq) (*Book, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetBookById not implemented")
 }
 func (*UnimplementedCatalogServiceServer) DeletedBookById(ctx context.Context, req *GetBookByIdReq) (*EmptyResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeletedBookById not implemented")
 }
+func (*UnimplementedCatalogServiceServer) RestoreBook(ctx context.Context, req *GetBookByIdReq) (*Book, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreBook not implemented")
+}
+func (*UnimplementedCatalogServiceServer) PurgeBook(ctx context.Context, req *GetBookByIdReq) (*EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeBook not implemented")
+}
 func (*UnimplementedCatalogServiceServer) ListBooks(ctx context.Context, req *ListBookReq) (*ListBookResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListBooks not implemented")
 }
@@ -276,12 +383,21 @@ func (*UnimplementedCatalogServiceServer) CreateAuthor(ctx context.Context, req
 func (*UnimplementedCatalogServiceServer) UpdateAuthor(ctx context.Context, req *Author) (*Author, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateAuthor not implemented")
 }
+func (*UnimplementedCatalogServiceServer) PatchAuthor(ctx context.Context, req *PatchAuthorReq) (*Author, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PatchAuthor not implemented")
+}
 func (*UnimplementedCatalogServiceServer) GetAuthorById(ctx context.Context, req *GetAuthorByIdReq) (*Author, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAuthorById not implemented")
 }
 func (*UnimplementedCatalogServiceServer) DeleteAuthorById(ctx context.Context, req *GetAuthorByIdReq) (*EmptyResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteAuthorById not implemented")
 }
+func (*UnimplementedCatalogServiceServer) RestoreAuthor(ctx context.Context, req *GetAuthorByIdReq) (*Author, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreAuthor not implemented")
+}
+func (*UnimplementedCatalogServiceServer) PurgeAuthor(ctx context.Context, req *GetAuthorByIdReq) (*EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeAuthor not implemented")
+}
 func (*UnimplementedCatalogServiceServer) ListAuthors(ctx context.Context, req *ListAuthorReq) (*ListAuthorResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListAuthors not implemented")
 }
@@ -291,12 +407,21 @@ func (*UnimplementedCatalogServiceServer) CreateCategory(ctx context.Context, re
 func (*UnimplementedCatalogServiceServer) UpdateCategory(ctx context.Context, req *Category) (*Category, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateCategory not implemented")
 }
+func (*UnimplementedCatalogServiceServer) PatchCategory(ctx context.Context, req *PatchCategoryReq) (*Category, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PatchCategory not implemented")
+}
 func (*UnimplementedCatalogServiceServer) GetCategoryById(ctx context.Context, req *GetCategoryByIdReq) (*Category, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCategoryById not implemented")
 }
 func (*UnimplementedCatalogServiceServer) DeleteCategoryById(ctx context.Context, req *GetCategoryByIdReq) (*EmptyResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteCategoryById not implemented")
 }
+func (*UnimplementedCatalogServiceServer) RestoreCategory(ctx context.Context, req *GetCategoryByIdReq) (*Category, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreCategory not implemented")
+}
+func (*UnimplementedCatalogServiceServer) PurgeCategory(ctx context.Context, req *GetCategoryByIdReq) (*EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeCategory not implemented")
+}
 func (*UnimplementedCatalogServiceServer) ListCategories(ctx context.Context, req *ListCategoryReq) (*ListCategoryResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListCategories not implemented")
 }
@@ -341,6 +466,24 @@ func _CatalogService_UpdateBook_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CatalogService_PatchBook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PatchBookReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).PatchBook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/catalog.CatalogService/PatchBook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).PatchBook(ctx, req.(*PatchBookReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CatalogService_GetBookById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetBookByIdReq)
 	if err := dec(in); err != nil {
@@ -377,6 +520,42 @@ func _CatalogService_DeletedBookById_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CatalogService_RestoreBook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBookByIdReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).RestoreBook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/catalog.CatalogService/RestoreBook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).RestoreBook(ctx, req.(*GetBookByIdReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_PurgeBook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBookByIdReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).PurgeBook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/catalog.CatalogService/PurgeBook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).PurgeBook(ctx, req.(*GetBookByIdReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CatalogService_ListBooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListBookReq)
 	if err := dec(in); err != nil {
@@ -431,6 +610,24 @@ func _CatalogService_UpdateAuthor_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CatalogService_PatchAuthor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PatchAuthorReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).PatchAuthor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/catalog.CatalogService/PatchAuthor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).PatchAuthor(ctx, req.(*PatchAuthorReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CatalogService_GetAuthorById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetAuthorByIdReq)
 	if err := dec(in); err != nil {
@@ -467,6 +664,42 @@ func _CatalogService_DeleteAuthorById_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CatalogService_RestoreAuthor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAuthorByIdReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).RestoreAuthor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/catalog.CatalogService/RestoreAuthor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).RestoreAuthor(ctx, req.(*GetAuthorByIdReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_PurgeAuthor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAuthorByIdReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).PurgeAuthor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/catalog.CatalogService/PurgeAuthor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).PurgeAuthor(ctx, req.(*GetAuthorByIdReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CatalogService_ListAuthors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListAuthorReq)
 	if err := dec(in); err != nil {
@@ -521,6 +754,24 @@ func _CatalogService_UpdateCategory_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CatalogService_PatchCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PatchCategoryReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).PatchCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/catalog.CatalogService/PatchCategory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).PatchCategory(ctx, req.(*PatchCategoryReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CatalogService_GetCategoryById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetCategoryByIdReq)
 	if err := dec(in); err != nil {
@@ -557,6 +808,42 @@ func _CatalogService_DeleteCategoryById_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CatalogService_RestoreCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCategoryByIdReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).RestoreCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/catalog.CatalogService/RestoreCategory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).RestoreCategory(ctx, req.(*GetCategoryByIdReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_PurgeCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCategoryByIdReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).PurgeCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/catalog.CatalogService/PurgeCategory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).PurgeCategory(ctx, req.(*GetCategoryByIdReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CatalogService_ListCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListCategoryReq)
 	if err := dec(in); err != nil {
@@ -587,6 +874,10 @@ var _CatalogService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateBook",
 			Handler:    _CatalogService_UpdateBook_Handler,
 		},
+		{
+			MethodName: "PatchBook",
+			Handler:    _CatalogService_PatchBook_Handler,
+		},
 		{
 			MethodName: "GetBookById",
 			Handler:    _CatalogService_GetBookById_Handler,
@@ -595,6 +886,14 @@ var _CatalogService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeletedBookById",
 			Handler:    _CatalogService_DeletedBookById_Handler,
 		},
+		{
+			MethodName: "RestoreBook",
+			Handler:    _CatalogService_RestoreBook_Handler,
+		},
+		{
+			MethodName: "PurgeBook",
+			Handler:    _CatalogService_PurgeBook_Handler,
+		},
 		{
 			MethodName: "ListBooks",
 			Handler:    _CatalogService_ListBooks_Handler,
@@ -607,6 +906,10 @@ var _CatalogService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateAuthor",
 			Handler:    _CatalogService_UpdateAuthor_Handler,
 		},
+		{
+			MethodName: "PatchAuthor",
+			Handler:    _CatalogService_PatchAuthor_Handler,
+		},
 		{
 			MethodName: "GetAuthorById",
 			Handler:    _CatalogService_GetAuthorById_Handler,
@@ -615,6 +918,14 @@ var _CatalogService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteAuthorById",
 			Handler:    _CatalogService_DeleteAuthorById_Handler,
 		},
+		{
+			MethodName: "RestoreAuthor",
+			Handler:    _CatalogService_RestoreAuthor_Handler,
+		},
+		{
+			MethodName: "PurgeAuthor",
+			Handler:    _CatalogService_PurgeAuthor_Handler,
+		},
 		{
 			MethodName: "ListAuthors",
 			Handler:    _CatalogService_ListAuthors_Handler,
@@ -627,6 +938,10 @@ var _CatalogService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateCategory",
 			Handler:    _CatalogService_UpdateCategory_Handler,
 		},
+		{
+			MethodName: "PatchCategory",
+			Handler:    _CatalogService_PatchCategory_Handler,
+		},
 		{
 			MethodName: "GetCategoryById",
 			Handler:    _CatalogService_GetCategoryById_Handler,
@@ -635,6 +950,14 @@ var _CatalogService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteCategoryById",
 			Handler:    _CatalogService_DeleteCategoryById_Handler,
 		},
+		{
+			MethodName: "RestoreCategory",
+			Handler:    _CatalogService_RestoreCategory_Handler,
+		},
+		{
+			MethodName: "PurgeCategory",
+			Handler:    _CatalogService_PurgeCategory_Handler,
+		},
 		{
 			MethodName: "ListCategories",
 			Handler:    _CatalogService_ListCategories_Handler,
@@ -27,20 +27,25 @@ const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 func init() { proto.RegisterFile("order_service/services.proto", fileDescriptor_edc415da3680c341) }
 
 var fileDescriptor_edc415da3680c341 = []byte{
-	// 197 bytes of a gzipped FileDescriptorProto
+	// 275 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xc9, 0x2f, 0x4a, 0x49,
 	0x2d, 0x8a, 0x2f, 0x4e, 0x2d, 0x2a, 0xcb, 0x4c, 0x4e, 0xd5, 0x87, 0xd2, 0xc5, 0x7a, 0x05, 0x45,
 	0xf9, 0x25, 0xf9, 0x42, 0xac, 0x60, 0x59, 0x29, 0x49, 0x54, 0x45, 0x60, 0x1e, 0x44, 0x85, 0xd1,
-	0x54, 0x26, 0x2e, 0x1e, 0x7f, 0x10, 0x3f, 0x18, 0x22, 0x29, 0xa4, 0xcd, 0xc5, 0xed, 0x5c, 0x94,
-	0x9a, 0x58, 0x92, 0x0a, 0x16, 0x15, 0xe2, 0xd1, 0x83, 0xa8, 0x06, 0xf3, 0xa4, 0x50, 0x78, 0x4a,
-	0x0c, 0x20, 0xc5, 0xa1, 0x05, 0x29, 0x44, 0x2a, 0x36, 0xe3, 0xe2, 0x71, 0x4f, 0x2d, 0x01, 0xf3,
-	0x9c, 0x2a, 0x3d, 0x53, 0x84, 0xc4, 0xa0, 0xf2, 0xc8, 0x82, 0x41, 0xa9, 0x85, 0x18, 0xfa, 0x2c,
-	0xb8, 0xb8, 0x5c, 0x52, 0x73, 0x52, 0x4b, 0x52, 0xf1, 0xea, 0x12, 0x80, 0x8a, 0xbb, 0xe6, 0x16,
-	0x94, 0x54, 0x06, 0xa5, 0x16, 0x17, 0x28, 0x31, 0x08, 0x59, 0x72, 0x71, 0xf9, 0x64, 0x16, 0x43,
-	0xd4, 0x15, 0x0b, 0x09, 0x43, 0x55, 0xc0, 0x85, 0x40, 0xda, 0x44, 0x30, 0x05, 0x41, 0x5a, 0x9d,
-	0x04, 0x4e, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x19, 0x8f,
-	0xe5, 0x18, 0x92, 0xd8, 0xc0, 0x01, 0x66, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0xfd, 0xa5, 0xe7,
-	0x07, 0x72, 0x01, 0x00, 0x00,
+	0x66, 0x16, 0x2e, 0x1e, 0x7f, 0x10, 0x3f, 0x18, 0x22, 0x29, 0xa4, 0xc9, 0xc5, 0xed, 0x5c, 0x94,
+	0x9a, 0x58, 0x92, 0x0a, 0x16, 0x15, 0xe2, 0xd1, 0x83, 0xa8, 0x06, 0xf3, 0xa4, 0x50, 0x78, 0x20,
+	0xa5, 0xa1, 0x05, 0x29, 0x44, 0x29, 0x35, 0xe0, 0xe2, 0x0a, 0x48, 0x2c, 0x49, 0xce, 0x80, 0xf0,
+	0x44, 0xa0, 0x72, 0x08, 0xa1, 0xa0, 0xd4, 0x42, 0x34, 0x1d, 0x56, 0x5c, 0xfc, 0x21, 0x45, 0x89,
+	0x79, 0xc5, 0x99, 0x25, 0x99, 0xf9, 0x79, 0x10, 0x21, 0x49, 0xa8, 0x02, 0x34, 0x71, 0x4c, 0xbd,
+	0x5e, 0x5c, 0xa2, 0xee, 0xa9, 0x25, 0x10, 0x6f, 0x95, 0x24, 0x96, 0x94, 0x16, 0x7b, 0x64, 0x16,
+	0x97, 0xe4, 0x17, 0x55, 0x0a, 0x89, 0x41, 0x95, 0xc1, 0x64, 0x9d, 0x2a, 0x3d, 0x53, 0x40, 0xda,
+	0x25, 0x91, 0xb5, 0xa3, 0x6a, 0x31, 0xe1, 0xe2, 0x41, 0x56, 0x8d, 0xd3, 0x08, 0x54, 0x17, 0x98,
+	0x71, 0x71, 0xb9, 0xa4, 0xe6, 0xa4, 0x96, 0xa4, 0xe2, 0xd5, 0x23, 0x00, 0x15, 0x77, 0xcd, 0x2d,
+	0x28, 0xa9, 0x0c, 0x4a, 0x2d, 0x2e, 0x00, 0xd9, 0x16, 0x94, 0x0a, 0xb2, 0x18, 0x1a, 0xa6, 0x44,
+	0xdb, 0x16, 0x50, 0x5a, 0x94, 0x4e, 0x40, 0x0f, 0xa6, 0x6d, 0xe6, 0x5c, 0x5c, 0x3e, 0x99, 0xc5,
+	0x10, 0x55, 0xc5, 0x42, 0xc2, 0x50, 0x79, 0xb8, 0x10, 0x48, 0x93, 0x08, 0xa6, 0x60, 0x71, 0x81,
+	0x93, 0xc0, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe3,
+	0xb1, 0x1c, 0x43, 0x12, 0x1b, 0x38, 0x39, 0x19, 0x03, 0x06, 0x00, 0xde, 0xe3, 0x21, 0x46, 0x90,
+	0x02, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -57,8 +62,13 @@ const _ = grpc.SupportPackageIsVersion4
 type OrderServiceClient interface {
 	CreateOrder(ctx context.Context, in *Order, opts ...grpc.CallOption) (*Order, error)
 	UpdateOrder(ctx context.Context, in *Order, opts ...grpc.CallOption) (*Order, error)
+	PatchOrder(ctx context.Context, in *PatchOrderReq, opts ...grpc.CallOption) (*Order, error)
+	TransitionOrder(ctx context.Context, in *TransitionOrderReq, opts ...grpc.CallOption) (*Order, error)
+	GetOrderStatusHistory(ctx context.Context, in *GetOrderByIdReq, opts ...grpc.CallOption) (*OrderStatusHistory, error)
 	GetOrderById(ctx context.Context, in *GetOrderByIdReq, opts ...grpc.CallOption) (*Order, error)
 	DeleteById(ctx context.Context, in *GetOrderByIdReq, opts ...grpc.CallOption) (*EmptyResp, error)
+	RestoreOrder(ctx context.Context, in *GetOrderByIdReq, opts ...grpc.CallOption) (*Order, error)
+	PurgeOrder(ctx context.Context, in *GetOrderByIdReq, opts ...grpc.CallOption) (*EmptyResp, error)
 	ListOrders(ctx context.Context, in *ListOrderReq, opts ...grpc.CallOption) (*ListOrderResp, error)
 }
 
@@ -88,6 +98,33 @@ func (c *orderServiceClient) UpdateOrder(ctx context.Context, in *Order, opts ..
 	return out, nil
 }
 
+func (c *orderServiceClient) PatchOrder(ctx context.Context, in *PatchOrderReq, opts ...grpc.CallOption) (*Order, error) {
+	out := new(Order)
+	err := c.cc.Invoke(ctx, "/order.OrderService/PatchOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) TransitionOrder(ctx context.Context, in *TransitionOrderReq, opts ...grpc.CallOption) (*Order, error) {
+	out := new(Order)
+	err := c.cc.Invoke(ctx, "/order.OrderService/TransitionOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) GetOrderStatusHistory(ctx context.Context, in *GetOrderByIdReq, opts ...grpc.CallOption) (*OrderStatusHistory, error) {
+	out := new(OrderStatusHistory)
+	err := c.cc.Invoke(ctx, "/order.OrderService/GetOrderStatusHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *orderServiceClient) GetOrderById(ctx context.Context, in *GetOrderByIdReq, opts ...grpc.CallOption) (*Order, error) {
 	out := new(Order)
 	err := c.cc.Invoke(ctx, "/order.OrderService/GetOrderById", in, out, opts...)
@@ -106,6 +143,24 @@ func (c *orderServiceClient) DeleteById(ctx context.Context, in *GetOrderByIdReq
 	return out, nil
 }
 
+func (c *orderServiceClient) RestoreOrder(ctx context.Context, in *GetOrderByIdReq, opts ...grpc.CallOption) (*Order, error) {
+	out := new(Order)
+	err := c.cc.Invoke(ctx, "/order.OrderService/RestoreOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) PurgeOrder(ctx context.Context, in *GetOrderByIdReq, opts ...grpc.CallOption) (*EmptyResp, error) {
+	out := new(EmptyResp)
+	err := c.cc.Invoke(ctx, "/order.OrderService/PurgeOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *orderServiceClient) ListOrders(ctx context.Context, in *ListOrderReq, opts ...grpc.CallOption) (*ListOrderResp, error) {
 	out := new(ListOrderResp)
 	err := c.cc.Invoke(ctx, "/order.OrderService/ListOrders", in, out, opts...)
@@ -119,8 +174,13 @@ func (c *orderServiceClient) ListOrders(ctx context.Context, in *ListOrderReq, o
 type OrderServiceServer interface {
 	CreateOrder(context.Context, *Order) (*Order, error)
 	UpdateOrder(context.Context, *Order) (*Order, error)
+	PatchOrder(context.Context, *PatchOrderReq) (*Order, error)
+	TransitionOrder(context.Context, *TransitionOrderReq) (*Order, error)
+	GetOrderStatusHistory(context.Context, *GetOrderByIdReq) (*OrderStatusHistory, error)
 	GetOrderById(context.Context, *GetOrderByIdReq) (*Order, error)
 	DeleteById(context.Context, *GetOrderByIdReq) (*EmptyResp, error)
+	RestoreOrder(context.Context, *GetOrderByIdReq) (*Order, error)
+	PurgeOrder(context.Context, *GetOrderByIdReq) (*EmptyResp, error)
 	ListOrders(context.Context, *ListOrderReq) (*ListOrderResp, error)
 }
 
@@ -134,12 +194,27 @@ func (*UnimplementedOrderServiceServer) CreateOrder(ctx context.Context, req *Or
 func (*UnimplementedOrderServiceServer) UpdateOrder(ctx context.Context, req *Order) (*Order, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateOrder not implemented")
 }
+func (*UnimplementedOrderServiceServer) PatchOrder(ctx context.Context, req *PatchOrderReq) (*Order, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PatchOrder not implemented")
+}
+func (*UnimplementedOrderServiceServer) TransitionOrder(ctx context.Context, req *TransitionOrderReq) (*Order, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransitionOrder not implemented")
+}
+func (*UnimplementedOrderServiceServer) GetOrderStatusHistory(ctx context.Context, req *GetOrderByIdReq) (*OrderStatusHistory, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrderStatusHistory not implemented")
+}
 func (*UnimplementedOrderServiceServer) GetOrderById(ctx context.Context, req *GetOrderByIdReq) (*Order, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetOrderById not implemented")
 }
 func (*UnimplementedOrderServiceServer) DeleteById(ctx context.Context, req *GetOrderByIdReq) (*EmptyResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteById not implemented")
 }
+func (*UnimplementedOrderServiceServer) RestoreOrder(ctx context.Context, req *GetOrderByIdReq) (*Order, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreOrder not implemented")
+}
+func (*UnimplementedOrderServiceServer) PurgeOrder(ctx context.Context, req *GetOrderByIdReq) (*EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeOrder not implemented")
+}
 func (*UnimplementedOrderServiceServer) ListOrders(ctx context.Context, req *ListOrderReq) (*ListOrderResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListOrders not implemented")
 }
@@ -184,6 +259,60 @@ func _OrderService_UpdateOrder_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OrderService_PatchOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PatchOrderReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).PatchOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/order.OrderService/PatchOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).PatchOrder(ctx, req.(*PatchOrderReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_TransitionOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransitionOrderReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).TransitionOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/order.OrderService/TransitionOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).TransitionOrder(ctx, req.(*TransitionOrderReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_GetOrderStatusHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrderByIdReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).GetOrderStatusHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/order.OrderService/GetOrderStatusHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).GetOrderStatusHistory(ctx, req.(*GetOrderByIdReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _OrderService_GetOrderById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetOrderByIdReq)
 	if err := dec(in); err != nil {
@@ -220,6 +349,42 @@ func _OrderService_DeleteById_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OrderService_RestoreOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrderByIdReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).RestoreOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/order.OrderService/RestoreOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).RestoreOrder(ctx, req.(*GetOrderByIdReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_PurgeOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrderByIdReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).PurgeOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/order.OrderService/PurgeOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).PurgeOrder(ctx, req.(*GetOrderByIdReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _OrderService_ListOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListOrderReq)
 	if err := dec(in); err != nil {
@@ -250,6 +415,18 @@ var _OrderService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateOrder",
 			Handler:    _OrderService_UpdateOrder_Handler,
 		},
+		{
+			MethodName: "PatchOrder",
+			Handler:    _OrderService_PatchOrder_Handler,
+		},
+		{
+			MethodName: "TransitionOrder",
+			Handler:    _OrderService_TransitionOrder_Handler,
+		},
+		{
+			MethodName: "GetOrderStatusHistory",
+			Handler:    _OrderService_GetOrderStatusHistory_Handler,
+		},
 		{
 			MethodName: "GetOrderById",
 			Handler:    _OrderService_GetOrderById_Handler,
@@ -258,6 +435,14 @@ var _OrderService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteById",
 			Handler:    _OrderService_DeleteById_Handler,
 		},
+		{
+			MethodName: "RestoreOrder",
+			Handler:    _OrderService_RestoreOrder_Handler,
+		},
+		{
+			MethodName: "PurgeOrder",
+			Handler:    _OrderService_PurgeOrder_Handler,
+		},
 		{
 			MethodName: "ListOrders",
 			Handler:    _OrderService_ListOrders_Handler,
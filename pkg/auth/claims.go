@@ -0,0 +1,52 @@
+package auth
+
+import (
+	"github.com/golang-jwt/jwt/v4"
+)
+
+// Roles
+const (
+	RoleAdmin    = "admin"
+	RoleCustomer = "customer"
+)
+
+// Permission ...
+type Permission string
+
+// Permissions checked by the router
+const (
+	PermCatalogWrite Permission = "catalog:write"
+	PermOrderRead    Permission = "order:read"
+	PermOrderWrite   Permission = "order:write"
+	// PermOrderManage grants access to the orders of every user, without it
+	// a caller only sees their own
+	PermOrderManage Permission = "order:manage"
+	PermPurge       Permission = "data:purge"
+)
+
+var rolePermissions = map[string][]Permission{
+	RoleAdmin:    {PermCatalogWrite, PermOrderRead, PermOrderWrite, PermOrderManage, PermPurge},
+	RoleCustomer: {PermOrderRead, PermOrderWrite},
+}
+
+// Claims are the claims carried by access tokens, the subject is the user id
+type Claims struct {
+	Role string `json:"role"`
+	jwt.RegisteredClaims
+}
+
+// UserID ...
+func (c *Claims) UserID() string {
+	return c.Subject
+}
+
+// HasPermission reports whether the token role grants the permission
+func (c *Claims) HasPermission(p Permission) bool {
+	for _, granted := range rolePermissions[c.Role] {
+		if granted == p {
+			return true
+		}
+	}
+
+	return false
+}
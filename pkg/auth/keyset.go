@@ -0,0 +1,135 @@
+package auth
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v4"
+
+	"github.com/muhriddinsalohiddin/online_store_api/config"
+)
+
+var (
+	// ErrNoKeys is returned when neither a signing key nor a key set file is configured
+	ErrNoKeys = errors.New("auth: no jwt keys configured")
+	// ErrUnknownKey is returned when a token references a key that is not in the set
+	ErrUnknownKey = errors.New("auth: unknown signing key")
+)
+
+type key struct {
+	alg string
+	key interface{}
+}
+
+// KeySet holds the keys tokens can be verified with, selected by the "kid" header
+type KeySet struct {
+	keys     map[string]key
+	issuer   string
+	audience string
+	parser   *jwt.Parser
+}
+
+// keySetFile is the on-disk format of JWTKeySetFile:
+//
+//	{"keys": [{"kid": "k1", "alg": "HS256", "secret": "..."},
+//	          {"kid": "k2", "alg": "RS256", "public_key": "-----BEGIN PUBLIC KEY-----..."}]}
+type keySetFile struct {
+	Keys []struct {
+		Kid       string `json:"kid"`
+		Alg       string `json:"alg"`
+		Secret    string `json:"secret"`
+		PublicKey string `json:"public_key"`
+	} `json:"keys"`
+}
+
+// LoadKeySet builds a KeySet from the JWT settings in config
+func LoadKeySet(cfg *config.Config) (*KeySet, error) {
+	ks := &KeySet{
+		keys:     make(map[string]key),
+		issuer:   cfg.JWTIssuer,
+		audience: cfg.JWTAudience,
+		parser: jwt.NewParser(jwt.WithValidMethods([]string{
+			jwt.SigningMethodHS256.Alg(),
+			jwt.SigningMethodRS256.Alg(),
+		})),
+	}
+
+	if cfg.JWTKeySetFile != "" {
+		data, err := ioutil.ReadFile(cfg.JWTKeySetFile)
+		if err != nil {
+			return nil, fmt.Errorf("auth: reading key set: %w", err)
+		}
+
+		var file keySetFile
+		if err := json.Unmarshal(data, &file); err != nil {
+			return nil, fmt.Errorf("auth: parsing key set: %w", err)
+		}
+
+		for _, k := range file.Keys {
+			switch strings.ToUpper(k.Alg) {
+			case jwt.SigningMethodHS256.Alg():
+				if k.Secret == "" {
+					return nil, fmt.Errorf("auth: key %q has no secret", k.Kid)
+				}
+				ks.keys[k.Kid] = key{alg: jwt.SigningMethodHS256.Alg(), key: []byte(k.Secret)}
+			case jwt.SigningMethodRS256.Alg():
+				pub, err := jwt.ParseRSAPublicKeyFromPEM([]byte(k.PublicKey))
+				if err != nil {
+					return nil, fmt.Errorf("auth: key %q: %w", k.Kid, err)
+				}
+				ks.keys[k.Kid] = key{alg: jwt.SigningMethodRS256.Alg(), key: pub}
+			default:
+				return nil, fmt.Errorf("auth: key %q has unsupported alg %q", k.Kid, k.Alg)
+			}
+		}
+	}
+
+	if cfg.JWTSigningKey != "" {
+		ks.keys[""] = key{alg: jwt.SigningMethodHS256.Alg(), key: []byte(cfg.JWTSigningKey)}
+	}
+
+	if len(ks.keys) == 0 {
+		return nil, ErrNoKeys
+	}
+
+	return ks, nil
+}
+
+// ParseToken verifies the token signature and standard claims and returns its claims
+func (ks *KeySet) ParseToken(tokenString string) (*Claims, error) {
+	claims := &Claims{}
+
+	_, err := ks.parser.ParseWithClaims(tokenString, claims, ks.keyFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	if ks.issuer != "" && !claims.VerifyIssuer(ks.issuer, true) {
+		return nil, errors.New("auth: invalid issuer")
+	}
+	if ks.audience != "" && !claims.VerifyAudience(ks.audience, true) {
+		return nil, errors.New("auth: invalid audience")
+	}
+
+	return claims, nil
+}
+
+func (ks *KeySet) keyFunc(token *jwt.Token) (interface{}, error) {
+	kid, _ := token.Header["kid"].(string)
+
+	k, ok := ks.keys[kid]
+	if !ok {
+		return nil, ErrUnknownKey
+	}
+
+	// a token must be signed with the algorithm its key was registered for,
+	// otherwise an RS256 public key could be used as an HS256 secret
+	if token.Method.Alg() != k.alg {
+		return nil, fmt.Errorf("auth: unexpected signing method %q", token.Method.Alg())
+	}
+
+	return k.key, nil
+}
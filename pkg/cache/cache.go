@@ -0,0 +1,41 @@
+// Package cache provides the stores the gateway caches backend responses in
+package cache
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/muhriddinsalohiddin/online_store_api/config"
+)
+
+// Cache backends selectable with config.CacheBackend
+const (
+	BackendMemory = "memory"
+	BackendRedis  = "redis"
+	BackendNone   = "none"
+)
+
+// Cache stores encoded values under string keys for a limited time
+type Cache interface {
+	// Get reports whether key holds a value that hasn't expired
+	Get(ctx context.Context, key string) ([]byte, bool, error)
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+	Delete(ctx context.Context, keys ...string) error
+	Close() error
+}
+
+// New builds the cache selected by cfg.CacheBackend, it returns nil when
+// caching is disabled
+func New(cfg *config.Config) (Cache, error) {
+	switch cfg.CacheBackend {
+	case BackendMemory, "":
+		return NewLRU(cfg.CacheSize), nil
+	case BackendRedis:
+		return NewRedis(cfg.RedisAddr, cfg.RedisPassword, cfg.RedisDB)
+	case BackendNone:
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("cache: unknown backend %q", cfg.CacheBackend)
+	}
+}
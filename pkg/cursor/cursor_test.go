@@ -0,0 +1,126 @@
+package cursor
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+// signPayload issues a token for an arbitrary payload, to forge expiries
+func signPayload(s *Signer, p payload) string {
+	data, _ := json.Marshal(p)
+	body := encoding.EncodeToString(data)
+	return body + "." + encoding.EncodeToString(s.mac(body))
+}
+
+func TestSignerVerify(t *testing.T) {
+	signer := NewSigner("secret", time.Hour)
+	token := signer.Sign("books?author=a1", "offset:20")
+	body, sig, _ := cut(token, ".")
+
+	tests := []struct {
+		name    string
+		signer  *Signer
+		token   string
+		scope   string
+		want    string
+		wantErr error
+	}{
+		{
+			name:   "round trip",
+			signer: signer,
+			token:  token,
+			scope:  "books?author=a1",
+			want:   "offset:20",
+		},
+		{
+			name:   "never expires with a zero ttl",
+			signer: NewSigner("secret", 0),
+			token:  NewSigner("secret", 0).Sign("books", "p1"),
+			scope:  "books",
+			want:   "p1",
+		},
+		{
+			name:    "tampered signature",
+			signer:  signer,
+			token:   body + "." + strings.Repeat("A", len(sig)),
+			scope:   "books?author=a1",
+			wantErr: ErrSignature,
+		},
+		{
+			name:    "tampered payload",
+			signer:  signer,
+			token:   encoding.EncodeToString([]byte(`{"s":"books?author=a1","p":"offset:0"}`)) + "." + sig,
+			scope:   "books?author=a1",
+			wantErr: ErrSignature,
+		},
+		{
+			name:    "signed with another key",
+			signer:  NewSigner("other", time.Hour),
+			token:   token,
+			scope:   "books?author=a1",
+			wantErr: ErrSignature,
+		},
+		{
+			name:    "wrong resource",
+			signer:  signer,
+			token:   token,
+			scope:   "authors",
+			wantErr: ErrScope,
+		},
+		{
+			name:    "other filters of the same resource",
+			signer:  signer,
+			token:   token,
+			scope:   "books?author=a2",
+			wantErr: ErrScope,
+		},
+		{
+			name:    "expired",
+			signer:  signer,
+			token:   signPayload(signer, payload{Scope: "books", Position: "p1", Expires: time.Now().Add(-time.Minute).Unix()}),
+			scope:   "books",
+			wantErr: ErrExpired,
+		},
+		{
+			name:    "missing separator",
+			signer:  signer,
+			token:   "not-a-token",
+			scope:   "books",
+			wantErr: ErrMalformed,
+		},
+		{
+			name:    "signature isn't base64",
+			signer:  signer,
+			token:   body + ".!!!",
+			scope:   "books",
+			wantErr: ErrMalformed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.signer.Verify(tt.token, tt.scope)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Verify() error = %v, want %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Fatalf("Verify() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewSignerRandomKey(t *testing.T) {
+	a, b := NewSigner("", 0), NewSigner("", 0)
+	token := a.Sign("books", "p1")
+
+	if _, err := a.Verify(token, "books"); err != nil {
+		t.Fatalf("Verify() with the issuing signer: %v", err)
+	}
+	if _, err := b.Verify(token, "books"); !errors.Is(err, ErrSignature) {
+		t.Fatalf("Verify() with another random key error = %v, want %v", err, ErrSignature)
+	}
+}
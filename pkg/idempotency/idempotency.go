@@ -0,0 +1,58 @@
+// Package idempotency provides the stores the gateway remembers
+// Idempotency-Key requests and their responses in
+package idempotency
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/muhriddinsalohiddin/online_store_api/config"
+)
+
+// Store backends selectable with config.IdempotencyBackend
+const (
+	BackendMemory = "memory"
+	BackendRedis  = "redis"
+)
+
+// Record is what a store keeps under an idempotency key. A record without a
+// Status belongs to a request that is still being served.
+type Record struct {
+	Fingerprint string      `json:"fingerprint"`
+	Status      int         `json:"status,omitempty"`
+	Header      http.Header `json:"header,omitempty"`
+	Body        []byte      `json:"body,omitempty"`
+}
+
+// Completed reports whether the record holds a response to replay
+func (r *Record) Completed() bool {
+	return r.Status != 0
+}
+
+// Store remembers requests by idempotency key for a limited time
+type Store interface {
+	// Reserve atomically saves a pending record under key for ttl unless
+	// one already exists. It returns the existing record, or nil when the
+	// caller now owns the key.
+	Reserve(ctx context.Context, key, fingerprint string, ttl time.Duration) (*Record, error)
+	// Complete replaces the pending record with the final response, kept
+	// for ttl
+	Complete(ctx context.Context, key string, record *Record, ttl time.Duration) error
+	// Release forgets the key so the request can be retried
+	Release(ctx context.Context, key string) error
+	Close() error
+}
+
+// New builds the store selected by cfg.IdempotencyBackend
+func New(cfg *config.Config) (Store, error) {
+	switch cfg.IdempotencyBackend {
+	case BackendMemory, "":
+		return NewMemory(), nil
+	case BackendRedis:
+		return NewRedis(cfg.RedisAddr, cfg.RedisPassword, cfg.RedisDB)
+	default:
+		return nil, fmt.Errorf("idempotency: unknown backend %q", cfg.IdempotencyBackend)
+	}
+}
@@ -0,0 +1,115 @@
+package idempotency
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// sweepInterval is how often the expired records are dropped
+const sweepInterval = time.Minute
+
+type memoryEntry struct {
+	record  Record
+	expires time.Time
+}
+
+// Memory is an in-process Store, keys are only shared by requests served
+// by the same gateway instance
+type Memory struct {
+	mu      sync.Mutex
+	entries map[string]*memoryEntry
+
+	stop chan struct{}
+	once sync.Once
+}
+
+// NewMemory returns an empty Memory store, Close stops its sweeper
+func NewMemory() *Memory {
+	return newMemory(sweepInterval)
+}
+
+func newMemory(interval time.Duration) *Memory {
+	s := &Memory{
+		entries: make(map[string]*memoryEntry),
+		stop:    make(chan struct{}),
+	}
+	go s.sweeper(interval)
+
+	return s
+}
+
+// Reserve implements Store
+func (s *Memory) Reserve(_ context.Context, key, fingerprint string, ttl time.Duration) (*Record, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	if entry, ok := s.entries[key]; ok && now.Before(entry.expires) {
+		record := entry.record
+		return &record, nil
+	}
+
+	s.entries[key] = &memoryEntry{
+		record:  Record{Fingerprint: fingerprint},
+		expires: now.Add(ttl),
+	}
+
+	return nil, nil
+}
+
+// Complete implements Store
+func (s *Memory) Complete(_ context.Context, key string, record *Record, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.entries[key] = &memoryEntry{
+		record:  *record,
+		expires: time.Now().Add(ttl),
+	}
+
+	return nil
+}
+
+// Release implements Store
+func (s *Memory) Release(_ context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.entries, key)
+
+	return nil
+}
+
+// Close implements Store
+func (s *Memory) Close() error {
+	s.once.Do(func() { close(s.stop) })
+	return nil
+}
+
+// sweeper drops the expired records every interval until Close, keys that
+// are never used again would otherwise stay forever
+func (s *Memory) sweeper(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.stop:
+			return
+		case now := <-ticker.C:
+			s.sweep(now)
+		}
+	}
+}
+
+func (s *Memory) sweep(now time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for key, entry := range s.entries {
+		if !now.Before(entry.expires) {
+			delete(s.entries, key)
+		}
+	}
+}
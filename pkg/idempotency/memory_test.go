@@ -0,0 +1,141 @@
+package idempotency
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestMemory(t *testing.T) {
+	ctx := context.Background()
+	done := &Record{Fingerprint: "a", Status: http.StatusCreated, Body: []byte(`{"id":"1"}`)}
+
+	tests := []struct {
+		name string
+		// prepare runs before the Reserve under test
+		prepare         func(s *Memory)
+		ttl             time.Duration
+		wait            time.Duration
+		wantReserved    bool
+		wantFingerprint string
+		wantCompleted   bool
+		wantBody        string
+	}{
+		{
+			name:         "new key is reserved",
+			prepare:      func(s *Memory) {},
+			ttl:          time.Minute,
+			wantReserved: true,
+		},
+		{
+			name: "in flight key returns the pending record",
+			prepare: func(s *Memory) {
+				_, _ = s.Reserve(ctx, "k", "a", time.Minute)
+			},
+			ttl:             time.Minute,
+			wantFingerprint: "a",
+		},
+		{
+			name: "completed key returns the saved response",
+			prepare: func(s *Memory) {
+				_, _ = s.Reserve(ctx, "k", "a", time.Minute)
+				_ = s.Complete(ctx, "k", done, time.Minute)
+			},
+			ttl:             time.Minute,
+			wantFingerprint: "a",
+			wantCompleted:   true,
+			wantBody:        `{"id":"1"}`,
+		},
+		{
+			name: "mismatching body gets the fingerprint of the first request",
+			prepare: func(s *Memory) {
+				_, _ = s.Reserve(ctx, "k", "b", time.Minute)
+				_ = s.Complete(ctx, "k", &Record{Fingerprint: "b", Status: http.StatusCreated}, time.Minute)
+			},
+			ttl:             time.Minute,
+			wantFingerprint: "b",
+			wantCompleted:   true,
+		},
+		{
+			name: "released key is reserved again",
+			prepare: func(s *Memory) {
+				_, _ = s.Reserve(ctx, "k", "a", time.Minute)
+				_ = s.Release(ctx, "k")
+			},
+			ttl:          time.Minute,
+			wantReserved: true,
+		},
+		{
+			name: "expired key is reserved again",
+			prepare: func(s *Memory) {
+				_, _ = s.Reserve(ctx, "k", "a", time.Millisecond)
+				_ = s.Complete(ctx, "k", done, time.Millisecond)
+			},
+			ttl:          time.Minute,
+			wait:         5 * time.Millisecond,
+			wantReserved: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewMemory()
+			defer s.Close()
+			tt.prepare(s)
+			time.Sleep(tt.wait)
+
+			record, err := s.Reserve(ctx, "k", "a", tt.ttl)
+			if err != nil {
+				t.Fatalf("Reserve: %v", err)
+			}
+			if tt.wantReserved {
+				if record != nil {
+					t.Fatalf("Reserve returned %+v, want the key reserved", record)
+				}
+				return
+			}
+
+			if record == nil {
+				t.Fatal("Reserve reserved the key, want the existing record")
+			}
+			if record.Fingerprint != tt.wantFingerprint {
+				t.Errorf("Fingerprint = %q, want %q", record.Fingerprint, tt.wantFingerprint)
+			}
+			if record.Completed() != tt.wantCompleted {
+				t.Errorf("Completed() = %v, want %v", record.Completed(), tt.wantCompleted)
+			}
+			if string(record.Body) != tt.wantBody {
+				t.Errorf("Body = %s, want %s", record.Body, tt.wantBody)
+			}
+		})
+	}
+}
+
+func TestMemorySweep(t *testing.T) {
+	ctx := context.Background()
+	s := newMemory(time.Millisecond)
+	defer s.Close()
+	_, _ = s.Reserve(ctx, "old", "a", time.Millisecond)
+	_, _ = s.Reserve(ctx, "new", "a", time.Minute)
+
+	// the sweeper drops the expired key without another Reserve
+	deadline := time.Now().Add(time.Second)
+	for {
+		s.mu.Lock()
+		_, old := s.entries["old"]
+		_, fresh := s.entries["new"]
+		s.mu.Unlock()
+
+		if !fresh {
+			t.Fatal("key that didn't expire was swept")
+		}
+		if !old {
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("expired key survived the sweep")
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
@@ -0,0 +1,90 @@
+package idempotency
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+// keyPrefix keeps the records apart from the catalog cache when both use
+// the same server
+const keyPrefix = "idempotency:"
+
+// Redis is a Store backed by a Redis compatible server, shared by every
+// gateway instance
+type Redis struct {
+	client *redis.Client
+}
+
+// NewRedis connects to the server at addr and checks it answers
+func NewRedis(addr, password string, db int) (*Redis, error) {
+	client := redis.NewClient(&redis.Options{
+		Addr:     addr,
+		Password: password,
+		DB:       db,
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := client.Ping(ctx).Err(); err != nil {
+		client.Close()
+		return nil, err
+	}
+
+	return &Redis{client: client}, nil
+}
+
+// Reserve implements Store
+func (s *Redis) Reserve(ctx context.Context, key, fingerprint string, ttl time.Duration) (*Record, error) {
+	pending, err := json.Marshal(&Record{Fingerprint: fingerprint})
+	if err != nil {
+		return nil, err
+	}
+
+	reserved, err := s.client.SetNX(ctx, keyPrefix+key, pending, ttl).Result()
+	if err != nil {
+		return nil, err
+	}
+	if reserved {
+		return nil, nil
+	}
+
+	value, err := s.client.Get(ctx, keyPrefix+key).Bytes()
+	if errors.Is(err, redis.Nil) {
+		// expired between the two calls, try again
+		return s.Reserve(ctx, key, fingerprint, ttl)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var record Record
+	if err := json.Unmarshal(value, &record); err != nil {
+		return nil, err
+	}
+
+	return &record, nil
+}
+
+// Complete implements Store
+func (s *Redis) Complete(ctx context.Context, key string, record *Record, ttl time.Duration) error {
+	value, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+
+	return s.client.Set(ctx, keyPrefix+key, value, ttl).Err()
+}
+
+// Release implements Store
+func (s *Redis) Release(ctx context.Context, key string) error {
+	return s.client.Del(ctx, keyPrefix+key).Err()
+}
+
+// Close implements Store
+func (s *Redis) Close() error {
+	return s.client.Close()
+}
@@ -0,0 +1,21 @@
+package logger
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+// WithTrace returns a logger that adds the trace and span ids of the span in ctx
+// to every line, or l itself when ctx carries no span
+func WithTrace(ctx context.Context, l Logger) Logger {
+	sc := trace.SpanContextFromContext(ctx)
+	if !sc.IsValid() {
+		return l
+	}
+
+	return WithFields(l,
+		String("trace_id", sc.TraceID().String()),
+		String("span_id", sc.SpanID().String()),
+	)
+}
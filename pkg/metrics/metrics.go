@@ -0,0 +1,68 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const namespace = "api_gateway"
+
+// HTTP server metrics, route is the gin route template, e.g. /v1/books/:id
+var (
+	HTTPRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "http_requests_total",
+		Help:      "Number of HTTP requests handled.",
+	}, []string{"method", "route", "status"})
+
+	HTTPRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "http_request_duration_seconds",
+		Help:      "Latency of HTTP requests.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "route", "status"})
+
+	HTTPRequestsInFlight = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "http_requests_in_flight",
+		Help:      "Number of HTTP requests being served.",
+	}, []string{"method", "route"})
+)
+
+// gRPC client metrics, service and method come from the full RPC name,
+// e.g. catalog.CatalogService and GetBookById
+var (
+	GRPCClientRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "grpc_client_requests_total",
+		Help:      "Number of RPCs made to the backends by status code.",
+	}, []string{"service", "method", "code"})
+
+	GRPCClientRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "grpc_client_request_duration_seconds",
+		Help:      "Latency of RPCs made to the backends, retries included.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"service", "method"})
+
+	GRPCClientRetries = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "grpc_client_retries_total",
+		Help:      "Number of RPC attempts repeated after a retryable error.",
+	}, []string{"service", "method"})
+
+	GRPCClientTimeouts = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "grpc_client_timeouts_total",
+		Help:      "Number of RPCs that ran out of their deadline.",
+	}, []string{"service", "method"})
+)
+
+// Cache metrics, result is hit, miss or error
+var (
+	CacheRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "cache_requests_total",
+		Help:      "Number of catalog cache lookups by entity and result.",
+	}, []string{"entity", "result"})
+)
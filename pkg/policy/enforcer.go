@@ -0,0 +1,96 @@
+package policy
+
+import (
+	"context"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/casbin/casbin/v2"
+
+	"github.com/muhriddinsalohiddin/online_store_api/config"
+	"github.com/muhriddinsalohiddin/online_store_api/pkg/logger"
+)
+
+// Enforcer wraps a casbin enforcer and reloads its policy file when it changes
+type Enforcer struct {
+	enforcer   *casbin.SyncedEnforcer
+	policyPath string
+	log        logger.Logger
+
+	mu      sync.Mutex
+	modTime time.Time
+}
+
+// NewEnforcer loads the casbin model and policy files referenced in config
+func NewEnforcer(cfg *config.Config, log logger.Logger) (*Enforcer, error) {
+	enforcer, err := casbin.NewSyncedEnforcer(cfg.CasbinModelPath, cfg.CasbinPolicyPath)
+	if err != nil {
+		return nil, err
+	}
+
+	e := &Enforcer{
+		enforcer:   enforcer,
+		policyPath: cfg.CasbinPolicyPath,
+		log:        log,
+	}
+	if info, err := os.Stat(cfg.CasbinPolicyPath); err == nil {
+		e.modTime = info.ModTime()
+	}
+
+	return e, nil
+}
+
+// Enforce reports whether sub may perform act on obj
+func (e *Enforcer) Enforce(sub, obj, act string) (bool, error) {
+	return e.enforcer.Enforce(sub, obj, act)
+}
+
+// Reload re-reads the policy file. On failure the previous policy stays active.
+func (e *Enforcer) Reload() error {
+	return e.enforcer.LoadPolicy()
+}
+
+// Watch polls the policy file and reloads it whenever its modification time
+// changes, until ctx is done
+func (e *Enforcer) Watch(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			e.reloadIfChanged()
+		}
+	}
+}
+
+func (e *Enforcer) reloadIfChanged() {
+	info, err := os.Stat(e.policyPath)
+	if err != nil {
+		e.log.Error("failed to stat policy file", logger.Error(err), logger.String("path", e.policyPath))
+		return
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if info.ModTime().Equal(e.modTime) {
+		return
+	}
+	// remember the broken revision too, so it is reported once rather than on every tick
+	e.modTime = info.ModTime()
+
+	if err := e.Reload(); err != nil {
+		e.log.Error("failed to reload policy, keeping the previous one", logger.Error(err), logger.String("path", e.policyPath))
+		return
+	}
+
+	e.log.Info("policy reloaded", logger.String("path", e.policyPath))
+}
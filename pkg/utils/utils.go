@@ -11,6 +11,7 @@ type QueryParams struct {
 	Limit    int64
 	Ordering []string
 	Search   string
+	Cursor   string
 	Author   string
 	Category []string
 }
@@ -29,7 +30,7 @@ func ParseQueryParams(queryParams map[string][]string) (*QueryParams, []string)
 	for key, value := range queryParams {
 		if key == "page" {
 			params.Page, err = strconv.ParseInt(value[0], 10, 64)
-			if err != nil {
+			if err != nil || params.Page < 1 {
 				errStr = append(errStr, "Invalid `page` param")
 			}
 			continue
@@ -37,7 +38,7 @@ func ParseQueryParams(queryParams map[string][]string) (*QueryParams, []string)
 
 		if key == "limit" {
 			params.Limit, err = strconv.ParseInt(value[0], 10, 64)
-			if err != nil {
+			if err != nil || params.Limit < 1 {
 				errStr = append(errStr, "Invalid `limit` param")
 			}
 			continue
@@ -48,6 +49,11 @@ func ParseQueryParams(queryParams map[string][]string) (*QueryParams, []string)
 			continue
 		}
 
+		if key == "cursor" {
+			params.Cursor = value[0]
+			continue
+		}
+
 		if key == "ordering" {
 			params.Ordering = strings.Split(value[0], ",")
 			continue
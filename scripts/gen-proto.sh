@@ -7,7 +7,7 @@ for module in $(find $CURRENT_DIR/online_store_proto/* -type d); do
     protoc -I /usr/local/include \
            -I $GOPATH/src/github.com/gogo/protobuf/gogoproto \
            -I $CURRENT_DIR/online_store_proto/ \
-            --gofast_out=plugins=grpc:$CURRENT_DIR/genproto/ \
+            --gofast_out=plugins=grpc,Mgoogle/protobuf/field_mask.proto=github.com/gogo/protobuf/types:$CURRENT_DIR/genproto/ \
             $module/*.proto;
 done;
 
@@ -0,0 +1,120 @@
+package services
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type breakerState int
+
+const (
+	stateClosed breakerState = iota
+	stateOpen
+	stateHalfOpen
+)
+
+// failureCodes are the codes that say the backend is unhealthy, business
+// errors like NotFound or InvalidArgument don't trip the breaker
+var failureCodes = map[codes.Code]bool{
+	codes.Unavailable:      true,
+	codes.DeadlineExceeded: true,
+	codes.Internal:         true,
+	codes.Unknown:          true,
+}
+
+// circuitBreaker opens after maxFailures consecutive failures and short-circuits
+// calls with codes.Unavailable until openTimeout passes, then lets a single
+// trial call through to decide whether to close again
+type circuitBreaker struct {
+	name        string
+	maxFailures int
+	openTimeout time.Duration
+
+	mu       sync.Mutex
+	state    breakerState
+	failures int
+	openedAt time.Time
+}
+
+func newCircuitBreaker(name string, maxFailures int, openTimeout time.Duration) *circuitBreaker {
+	return &circuitBreaker{
+		name:        name,
+		maxFailures: maxFailures,
+		openTimeout: openTimeout,
+	}
+}
+
+func (b *circuitBreaker) allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case stateOpen:
+		if time.Since(b.openedAt) < b.openTimeout {
+			return false
+		}
+		b.state = stateHalfOpen
+		return true
+	case stateHalfOpen:
+		// a trial call is already in flight
+		return false
+	default:
+		return true
+	}
+}
+
+func (b *circuitBreaker) record(err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if err == nil || !failureCodes[status.Code(err)] {
+		b.state = stateClosed
+		b.failures = 0
+		return
+	}
+
+	b.failures++
+	if b.state == stateHalfOpen || b.failures >= b.maxFailures {
+		b.state = stateOpen
+		b.openedAt = time.Now()
+	}
+}
+
+func (b *circuitBreaker) interceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if b.maxFailures <= 0 {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+
+		if !b.allow() {
+			return status.Errorf(codes.Unavailable, "%s: circuit breaker is open", b.name)
+		}
+
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		// the caller giving up says nothing about the backend health
+		if status.Code(err) == codes.Canceled {
+			b.release()
+			return err
+		}
+		b.record(err)
+
+		return err
+	}
+}
+
+// release puts a half-open breaker back to open without counting a failure,
+// so the next call can run the trial again
+func (b *circuitBreaker) release() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.state == stateHalfOpen {
+		b.state = stateOpen
+		b.openedAt = time.Time{}
+	}
+}
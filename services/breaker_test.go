@@ -0,0 +1,146 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// call runs one call through the breaker with an invoker answering code and
+// reports whether the invoker was reached
+func call(b *circuitBreaker, code codes.Code) (bool, error) {
+	invoked := false
+	invoker := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		invoked = true
+		if code == codes.OK {
+			return nil
+		}
+		return status.Error(code, "backend")
+	}
+
+	err := b.interceptor()(context.Background(), "/catalog.CatalogService/GetBookById", nil, nil, nil, invoker)
+	return invoked, err
+}
+
+func TestCircuitBreaker(t *testing.T) {
+	type step struct {
+		code        codes.Code
+		elapse      bool // let the open timeout pass before the call
+		wantInvoked bool
+		wantState   breakerState
+	}
+
+	tests := []struct {
+		name  string
+		steps []step
+	}{
+		{
+			name: "opens after max consecutive failures",
+			steps: []step{
+				{code: codes.Unavailable, wantInvoked: true, wantState: stateClosed},
+				{code: codes.Unavailable, wantInvoked: true, wantState: stateClosed},
+				{code: codes.DeadlineExceeded, wantInvoked: true, wantState: stateOpen},
+				{code: codes.OK, wantInvoked: false, wantState: stateOpen},
+			},
+		},
+		{
+			name: "success resets the failure count",
+			steps: []step{
+				{code: codes.Unavailable, wantInvoked: true, wantState: stateClosed},
+				{code: codes.Unavailable, wantInvoked: true, wantState: stateClosed},
+				{code: codes.OK, wantInvoked: true, wantState: stateClosed},
+				{code: codes.Unavailable, wantInvoked: true, wantState: stateClosed},
+				{code: codes.Unavailable, wantInvoked: true, wantState: stateClosed},
+			},
+		},
+		{
+			name: "business errors don't count as failures",
+			steps: []step{
+				{code: codes.NotFound, wantInvoked: true, wantState: stateClosed},
+				{code: codes.InvalidArgument, wantInvoked: true, wantState: stateClosed},
+				{code: codes.AlreadyExists, wantInvoked: true, wantState: stateClosed},
+				{code: codes.Unavailable, wantInvoked: true, wantState: stateClosed},
+			},
+		},
+		{
+			name: "half-open trial success closes",
+			steps: []step{
+				{code: codes.Unavailable, wantInvoked: true, wantState: stateClosed},
+				{code: codes.Unavailable, wantInvoked: true, wantState: stateClosed},
+				{code: codes.Unavailable, wantInvoked: true, wantState: stateOpen},
+				{code: codes.OK, elapse: true, wantInvoked: true, wantState: stateClosed},
+				{code: codes.OK, wantInvoked: true, wantState: stateClosed},
+			},
+		},
+		{
+			name: "half-open trial failure opens again",
+			steps: []step{
+				{code: codes.Internal, wantInvoked: true, wantState: stateClosed},
+				{code: codes.Internal, wantInvoked: true, wantState: stateClosed},
+				{code: codes.Internal, wantInvoked: true, wantState: stateOpen},
+				{code: codes.Unavailable, elapse: true, wantInvoked: true, wantState: stateOpen},
+				{code: codes.OK, wantInvoked: false, wantState: stateOpen},
+			},
+		},
+		{
+			name: "canceled trial leaves the breaker open for the next trial",
+			steps: []step{
+				{code: codes.Unknown, wantInvoked: true, wantState: stateClosed},
+				{code: codes.Unknown, wantInvoked: true, wantState: stateClosed},
+				{code: codes.Unknown, wantInvoked: true, wantState: stateOpen},
+				{code: codes.Canceled, elapse: true, wantInvoked: true, wantState: stateOpen},
+				{code: codes.OK, wantInvoked: true, wantState: stateClosed},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := newCircuitBreaker("catalog", 3, time.Hour)
+			for i, s := range tt.steps {
+				if s.elapse {
+					b.mu.Lock()
+					b.openedAt = time.Now().Add(-2 * b.openTimeout)
+					b.mu.Unlock()
+				}
+
+				invoked, err := call(b, s.code)
+				if invoked != s.wantInvoked {
+					t.Fatalf("step %d: invoked = %v, want %v", i, invoked, s.wantInvoked)
+				}
+				if !invoked && status.Code(err) != codes.Unavailable {
+					t.Fatalf("step %d: short-circuited call returned %v, want Unavailable", i, err)
+				}
+				if b.state != s.wantState {
+					t.Fatalf("step %d: state = %v, want %v", i, b.state, s.wantState)
+				}
+			}
+		})
+	}
+}
+
+func TestCircuitBreakerHalfOpenAllowsSingleTrial(t *testing.T) {
+	b := newCircuitBreaker("catalog", 1, time.Hour)
+	b.record(status.Error(codes.Unavailable, "backend"))
+	b.openedAt = time.Now().Add(-2 * time.Hour)
+
+	if !b.allow() {
+		t.Fatal("first call after the open timeout was rejected")
+	}
+	if b.allow() {
+		t.Fatal("second call was let through while the trial is in flight")
+	}
+}
+
+func TestCircuitBreakerDisabled(t *testing.T) {
+	b := newCircuitBreaker("catalog", 0, time.Hour)
+	for i := 0; i < 5; i++ {
+		if invoked, _ := call(b, codes.Unavailable); !invoked {
+			t.Fatalf("call %d: disabled breaker short-circuited", i)
+		}
+	}
+}
@@ -0,0 +1,310 @@
+package services
+
+import (
+	"context"
+	"hash/fnv"
+	"sync/atomic"
+	"time"
+
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/sync/singleflight"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	pbCatalog "github.com/muhriddinsalohiddin/online_store_api/genproto/catalog_service"
+	"github.com/muhriddinsalohiddin/online_store_api/pkg/cache"
+	"github.com/muhriddinsalohiddin/online_store_api/pkg/metrics"
+)
+
+// cached entities, used in keys and metric labels
+const (
+	entityBook     = "book"
+	entityAuthor   = "author"
+	entityCategory = "category"
+)
+
+// cacheable is implemented by the generated messages
+type cacheable interface {
+	Marshal() ([]byte, error)
+	Unmarshal([]byte) error
+}
+
+// evictTimeout bounds the cache delete after a write
+const evictTimeout = 2 * time.Second
+
+// cacheGenerations is how many generation counters the keys are spread
+// over, keys sharing one only cost each other a cache fill
+const cacheGenerations = 1024
+
+type cacheTTLs struct {
+	book     time.Duration
+	author   time.Duration
+	category time.Duration
+}
+
+// cachedCatalogClient serves the single entity reads of CatalogService from
+// a cache. Concurrent misses of a key share one backend call, updates and
+// deletes made through the gateway evict the entity. A failing cache is
+// bypassed, it never fails a request. Every evict bumps the generation of
+// the key, a load that started before it doesn't cache what it read.
+type cachedCatalogClient struct {
+	pbCatalog.CatalogServiceClient
+
+	cache cache.Cache
+	ttls  cacheTTLs
+	group singleflight.Group
+	// loadTimeout bounds a shared backend call, it outlives the callers
+	// waiting for it
+	loadTimeout time.Duration
+	generations [cacheGenerations]uint32
+}
+
+func newCachedCatalogClient(client pbCatalog.CatalogServiceClient, c cache.Cache, ttls cacheTTLs, loadTimeout time.Duration) *cachedCatalogClient {
+	return &cachedCatalogClient{
+		CatalogServiceClient: client,
+		cache:                c,
+		ttls:                 ttls,
+		loadTimeout:          loadTimeout,
+	}
+}
+
+type bypassCacheKey struct{}
+
+// BypassCache makes the reads made with the returned context skip the
+// cached copy, for callers that must see the stored version
+func BypassCache(ctx context.Context) context.Context {
+	return context.WithValue(ctx, bypassCacheKey{}, true)
+}
+
+func cacheBypassed(ctx context.Context) bool {
+	bypass, _ := ctx.Value(bypassCacheKey{}).(bool)
+	return bypass
+}
+
+func cacheKey(entity, id string) string {
+	return "catalog:" + entity + ":" + id
+}
+
+// generation returns the counter evicts of key bump
+func (c *cachedCatalogClient) generation(key string) *uint32 {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(key))
+	return &c.generations[h.Sum32()%cacheGenerations]
+}
+
+// uncached reports whether the read goes straight to the backend, because
+// the entity isn't cached or the caller must see the stored version
+func uncached(ctx context.Context, entity string, ttl time.Duration) bool {
+	if ttl <= 0 {
+		return true
+	}
+	if cacheBypassed(ctx) {
+		metrics.CacheRequests.WithLabelValues(entity, "bypass").Inc()
+		return true
+	}
+
+	return false
+}
+
+// read decodes the cached entity into msg, or loads it once for every
+// concurrent caller and caches it for ttl. The shared load doesn't run with
+// the context of the caller that started it, a caller giving up only stops
+// its own wait.
+func (c *cachedCatalogClient) read(ctx context.Context, entity, id string, ttl time.Duration, msg cacheable, load func(context.Context) (cacheable, error)) error {
+	key := cacheKey(entity, id)
+	data, ok, err := c.cache.Get(ctx, key)
+	switch {
+	case err != nil:
+		metrics.CacheRequests.WithLabelValues(entity, "error").Inc()
+	case ok && msg.Unmarshal(data) == nil:
+		metrics.CacheRequests.WithLabelValues(entity, "hit").Inc()
+		return nil
+	default:
+		metrics.CacheRequests.WithLabelValues(entity, "miss").Inc()
+	}
+
+	loads := c.group.DoChan(key, func() (interface{}, error) {
+		loadCtx, cancel := context.WithTimeout(detach(ctx), c.loadTimeout)
+		defer cancel()
+
+		generation := c.generation(key)
+		started := atomic.LoadUint32(generation)
+		loaded, err := load(loadCtx)
+		if err != nil {
+			return nil, err
+		}
+		data, err := loaded.Marshal()
+		if err != nil {
+			return nil, err
+		}
+
+		// an evict since the load started means data may predate the write,
+		// and one landing during the Set may have run its delete before it
+		if atomic.LoadUint32(generation) == started {
+			_ = c.cache.Set(loadCtx, key, data, ttl)
+			if atomic.LoadUint32(generation) != started {
+				_ = c.cache.Delete(loadCtx, key)
+			}
+		}
+
+		return data, nil
+	})
+
+	select {
+	case <-ctx.Done():
+		return status.FromContextError(ctx.Err()).Err()
+	case result := <-loads:
+		if result.Err != nil {
+			return result.Err
+		}
+		// every caller decodes its own copy
+		return msg.Unmarshal(result.Val.([]byte))
+	}
+}
+
+// detach returns a context that only keeps the trace span and the outgoing
+// metadata (request id, caller) of ctx, a load shared by several callers
+// must not be cut short by the deadline or cancellation of one of them
+func detach(ctx context.Context) context.Context {
+	detached := trace.ContextWithSpanContext(context.Background(), trace.SpanContextFromContext(ctx))
+	if md, ok := metadata.FromOutgoingContext(ctx); ok {
+		detached = metadata.NewOutgoingContext(detached, md.Copy())
+	}
+
+	return detached
+}
+
+// evict drops the entity once the write is done, it doesn't depend on the
+// request context so a client going away can't leave a stale entry
+func (c *cachedCatalogClient) evict(entity, id string) {
+	ctx, cancel := context.WithTimeout(context.Background(), evictTimeout)
+	defer cancel()
+
+	key := cacheKey(entity, id)
+	atomic.AddUint32(c.generation(key), 1)
+	_ = c.cache.Delete(ctx, key)
+}
+
+func (c *cachedCatalogClient) GetBookById(ctx context.Context, in *pbCatalog.GetBookByIdReq, opts ...grpc.CallOption) (*pbCatalog.Book, error) {
+	if uncached(ctx, entityBook, c.ttls.book) {
+		return c.CatalogServiceClient.GetBookById(ctx, in, opts...)
+	}
+
+	book := &pbCatalog.Book{}
+	err := c.read(ctx, entityBook, in.Id, c.ttls.book, book, func(ctx context.Context) (cacheable, error) {
+		return c.CatalogServiceClient.GetBookById(ctx, in, opts...)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return book, nil
+}
+
+func (c *cachedCatalogClient) UpdateBook(ctx context.Context, in *pbCatalog.Book, opts ...grpc.CallOption) (*pbCatalog.Book, error) {
+	defer c.evict(entityBook, in.Id)
+	return c.CatalogServiceClient.UpdateBook(ctx, in, opts...)
+}
+
+func (c *cachedCatalogClient) PatchBook(ctx context.Context, in *pbCatalog.PatchBookReq, opts ...grpc.CallOption) (*pbCatalog.Book, error) {
+	defer c.evict(entityBook, in.GetBook().GetId())
+	return c.CatalogServiceClient.PatchBook(ctx, in, opts...)
+}
+
+func (c *cachedCatalogClient) DeletedBookById(ctx context.Context, in *pbCatalog.GetBookByIdReq, opts ...grpc.CallOption) (*pbCatalog.EmptyResp, error) {
+	defer c.evict(entityBook, in.Id)
+	return c.CatalogServiceClient.DeletedBookById(ctx, in, opts...)
+}
+
+func (c *cachedCatalogClient) RestoreBook(ctx context.Context, in *pbCatalog.GetBookByIdReq, opts ...grpc.CallOption) (*pbCatalog.Book, error) {
+	defer c.evict(entityBook, in.Id)
+	return c.CatalogServiceClient.RestoreBook(ctx, in, opts...)
+}
+
+func (c *cachedCatalogClient) PurgeBook(ctx context.Context, in *pbCatalog.GetBookByIdReq, opts ...grpc.CallOption) (*pbCatalog.EmptyResp, error) {
+	defer c.evict(entityBook, in.Id)
+	return c.CatalogServiceClient.PurgeBook(ctx, in, opts...)
+}
+
+func (c *cachedCatalogClient) GetAuthorById(ctx context.Context, in *pbCatalog.GetAuthorByIdReq, opts ...grpc.CallOption) (*pbCatalog.Author, error) {
+	if uncached(ctx, entityAuthor, c.ttls.author) {
+		return c.CatalogServiceClient.GetAuthorById(ctx, in, opts...)
+	}
+
+	author := &pbCatalog.Author{}
+	err := c.read(ctx, entityAuthor, in.Id, c.ttls.author, author, func(ctx context.Context) (cacheable, error) {
+		return c.CatalogServiceClient.GetAuthorById(ctx, in, opts...)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return author, nil
+}
+
+func (c *cachedCatalogClient) UpdateAuthor(ctx context.Context, in *pbCatalog.Author, opts ...grpc.CallOption) (*pbCatalog.Author, error) {
+	defer c.evict(entityAuthor, in.Id)
+	return c.CatalogServiceClient.UpdateAuthor(ctx, in, opts...)
+}
+
+func (c *cachedCatalogClient) PatchAuthor(ctx context.Context, in *pbCatalog.PatchAuthorReq, opts ...grpc.CallOption) (*pbCatalog.Author, error) {
+	defer c.evict(entityAuthor, in.GetAuthor().GetId())
+	return c.CatalogServiceClient.PatchAuthor(ctx, in, opts...)
+}
+
+func (c *cachedCatalogClient) DeleteAuthorById(ctx context.Context, in *pbCatalog.GetAuthorByIdReq, opts ...grpc.CallOption) (*pbCatalog.EmptyResp, error) {
+	defer c.evict(entityAuthor, in.Id)
+	return c.CatalogServiceClient.DeleteAuthorById(ctx, in, opts...)
+}
+
+func (c *cachedCatalogClient) RestoreAuthor(ctx context.Context, in *pbCatalog.GetAuthorByIdReq, opts ...grpc.CallOption) (*pbCatalog.Author, error) {
+	defer c.evict(entityAuthor, in.Id)
+	return c.CatalogServiceClient.RestoreAuthor(ctx, in, opts...)
+}
+
+func (c *cachedCatalogClient) PurgeAuthor(ctx context.Context, in *pbCatalog.GetAuthorByIdReq, opts ...grpc.CallOption) (*pbCatalog.EmptyResp, error) {
+	defer c.evict(entityAuthor, in.Id)
+	return c.CatalogServiceClient.PurgeAuthor(ctx, in, opts...)
+}
+
+func (c *cachedCatalogClient) GetCategoryById(ctx context.Context, in *pbCatalog.GetCategoryByIdReq, opts ...grpc.CallOption) (*pbCatalog.Category, error) {
+	if uncached(ctx, entityCategory, c.ttls.category) {
+		return c.CatalogServiceClient.GetCategoryById(ctx, in, opts...)
+	}
+
+	category := &pbCatalog.Category{}
+	err := c.read(ctx, entityCategory, in.Id, c.ttls.category, category, func(ctx context.Context) (cacheable, error) {
+		return c.CatalogServiceClient.GetCategoryById(ctx, in, opts...)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return category, nil
+}
+
+func (c *cachedCatalogClient) UpdateCategory(ctx context.Context, in *pbCatalog.Category, opts ...grpc.CallOption) (*pbCatalog.Category, error) {
+	defer c.evict(entityCategory, in.Id)
+	return c.CatalogServiceClient.UpdateCategory(ctx, in, opts...)
+}
+
+func (c *cachedCatalogClient) PatchCategory(ctx context.Context, in *pbCatalog.PatchCategoryReq, opts ...grpc.CallOption) (*pbCatalog.Category, error) {
+	defer c.evict(entityCategory, in.GetCategory().GetId())
+	return c.CatalogServiceClient.PatchCategory(ctx, in, opts...)
+}
+
+func (c *cachedCatalogClient) DeleteCategoryById(ctx context.Context, in *pbCatalog.GetCategoryByIdReq, opts ...grpc.CallOption) (*pbCatalog.EmptyResp, error) {
+	defer c.evict(entityCategory, in.Id)
+	return c.CatalogServiceClient.DeleteCategoryById(ctx, in, opts...)
+}
+
+func (c *cachedCatalogClient) RestoreCategory(ctx context.Context, in *pbCatalog.GetCategoryByIdReq, opts ...grpc.CallOption) (*pbCatalog.Category, error) {
+	defer c.evict(entityCategory, in.Id)
+	return c.CatalogServiceClient.RestoreCategory(ctx, in, opts...)
+}
+
+func (c *cachedCatalogClient) PurgeCategory(ctx context.Context, in *pbCatalog.GetCategoryByIdReq, opts ...grpc.CallOption) (*pbCatalog.EmptyResp, error) {
+	defer c.evict(entityCategory, in.Id)
+	return c.CatalogServiceClient.PurgeCategory(ctx, in, opts...)
+}
@@ -0,0 +1,147 @@
+package services
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	pbCatalog "github.com/muhriddinsalohiddin/online_store_api/genproto/catalog_service"
+	"github.com/muhriddinsalohiddin/online_store_api/pkg/cache"
+)
+
+// slowCatalog answers GetBookById once release is closed
+type slowCatalog struct {
+	pbCatalog.CatalogServiceClient
+
+	calls   int32
+	started chan struct{}
+	release chan struct{}
+}
+
+func (s *slowCatalog) GetBookById(ctx context.Context, in *pbCatalog.GetBookByIdReq, opts ...grpc.CallOption) (*pbCatalog.Book, error) {
+	if atomic.AddInt32(&s.calls, 1) == 1 {
+		close(s.started)
+	}
+
+	select {
+	case <-ctx.Done():
+		return nil, status.FromContextError(ctx.Err()).Err()
+	case <-s.release:
+		return &pbCatalog.Book{Id: in.Id, Name: "Dune"}, nil
+	}
+}
+
+func TestCachedReadOutlivesCaller(t *testing.T) {
+	backend := &slowCatalog{started: make(chan struct{}), release: make(chan struct{})}
+	lru := cache.NewLRU(10)
+	client := newCachedCatalogClient(backend, lru, cacheTTLs{book: time.Minute}, time.Second)
+
+	first, cancel := context.WithCancel(context.Background())
+	firstErr := make(chan error, 1)
+	go func() {
+		_, err := client.GetBookById(first, &pbCatalog.GetBookByIdReq{Id: "1"})
+		firstErr <- err
+	}()
+	<-backend.started
+
+	second := make(chan *pbCatalog.Book, 1)
+	go func() {
+		book, err := client.GetBookById(context.Background(), &pbCatalog.GetBookByIdReq{Id: "1"})
+		if err != nil {
+			t.Errorf("second caller: %v", err)
+		}
+		second <- book
+	}()
+
+	// the caller that started the load goes away
+	cancel()
+	if err := <-firstErr; status.Code(err) != codes.Canceled {
+		t.Fatalf("first caller error = %v, want Canceled", err)
+	}
+
+	close(backend.release)
+	if book := <-second; book.GetName() != "Dune" {
+		t.Fatalf("second caller got %v, want the loaded book", book)
+	}
+	if calls := atomic.LoadInt32(&backend.calls); calls != 1 {
+		t.Fatalf("backend called %d times, want 1", calls)
+	}
+	if _, ok, _ := lru.Get(context.Background(), cacheKey(entityBook, "1")); !ok {
+		t.Fatal("loaded book wasn't cached")
+	}
+}
+
+func TestCachedReadEvictedWhileLoading(t *testing.T) {
+	backend := &slowCatalog{started: make(chan struct{}), release: make(chan struct{})}
+	lru := cache.NewLRU(10)
+	client := newCachedCatalogClient(backend, lru, cacheTTLs{book: time.Minute}, time.Second)
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := client.GetBookById(context.Background(), &pbCatalog.GetBookByIdReq{Id: "1"})
+		done <- err
+	}()
+	<-backend.started
+
+	// a write lands while the book is being read
+	client.evict(entityBook, "1")
+	close(backend.release)
+
+	if err := <-done; err != nil {
+		t.Fatalf("GetBookById: %v", err)
+	}
+	if _, ok, _ := lru.Get(context.Background(), cacheKey(entityBook, "1")); ok {
+		t.Fatal("book read before the evict was cached")
+	}
+}
+
+func TestBypassedReadSkipsSharedLoad(t *testing.T) {
+	backend := &slowCatalog{started: make(chan struct{}), release: make(chan struct{})}
+	client := newCachedCatalogClient(backend, cache.NewLRU(10), cacheTTLs{book: time.Minute}, time.Second)
+
+	go func() {
+		_, _ = client.GetBookById(context.Background(), &pbCatalog.GetBookByIdReq{Id: "1"})
+	}()
+	<-backend.started
+
+	bypassed := make(chan error, 1)
+	go func() {
+		_, err := client.GetBookById(BypassCache(context.Background()), &pbCatalog.GetBookByIdReq{Id: "1"})
+		bypassed <- err
+	}()
+
+	// the bypassed read makes its own backend call instead of waiting for
+	// the one in flight
+	deadline := time.Now().Add(time.Second)
+	for atomic.LoadInt32(&backend.calls) < 2 {
+		if time.Now().After(deadline) {
+			t.Fatal("bypassed read joined the shared load")
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	close(backend.release)
+	if err := <-bypassed; err != nil {
+		t.Fatalf("bypassed read: %v", err)
+	}
+}
+
+func TestDetachKeepsMetadata(t *testing.T) {
+	ctx, cancel := context.WithCancel(metadata.AppendToOutgoingContext(context.Background(), "x-request-id", "req-1"))
+	detached := detach(ctx)
+	cancel()
+
+	if detached.Err() != nil {
+		t.Fatal("detached context was canceled with its parent")
+	}
+	md, _ := metadata.FromOutgoingContext(detached)
+	if got := md.Get("x-request-id"); len(got) != 1 || got[0] != "req-1" {
+		t.Fatalf("x-request-id = %v, want [req-1]", got)
+	}
+}
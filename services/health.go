@@ -0,0 +1,68 @@
+package services
+
+import (
+	"context"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/connectivity"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+// DependencyStatus is the health of a single backend connection
+type DependencyStatus struct {
+	Healthy bool
+	// State is the gRPC connectivity state of the connection
+	State string
+	// Serving is the grpc.health.v1 serving status, empty when the
+	// backend doesn't implement the health service
+	Serving string
+	Error   string
+}
+
+// Check probes every backend concurrently. A backend is healthy when its
+// grpc.health.v1 service reports SERVING, or, when it doesn't expose the
+// health service, when its connection is READY.
+func (s *serviceManager) Check(ctx context.Context) map[string]DependencyStatus {
+	var (
+		mu     sync.Mutex
+		wg     sync.WaitGroup
+		result = make(map[string]DependencyStatus, len(s.conns))
+	)
+
+	for name, conn := range s.conns {
+		wg.Add(1)
+		go func(name string, conn *grpc.ClientConn) {
+			defer wg.Done()
+
+			st := checkConn(ctx, conn)
+
+			mu.Lock()
+			result[name] = st
+			mu.Unlock()
+		}(name, conn)
+	}
+	wg.Wait()
+
+	return result
+}
+
+func checkConn(ctx context.Context, conn *grpc.ClientConn) DependencyStatus {
+	resp, err := healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{})
+	state := conn.GetState()
+
+	st := DependencyStatus{State: state.String()}
+	switch {
+	case err == nil:
+		st.Serving = resp.GetStatus().String()
+		st.Healthy = resp.GetStatus() == healthpb.HealthCheckResponse_SERVING
+	case status.Code(err) == codes.Unimplemented:
+		st.Healthy = state == connectivity.Ready
+	default:
+		st.Error = status.Convert(err).Message()
+	}
+
+	return st
+}
@@ -0,0 +1,42 @@
+package services
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/muhriddinsalohiddin/online_store_api/pkg/metrics"
+)
+
+// metricsInterceptor records the outcome and latency of every RPC
+func metricsInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		service, rpc := splitMethod(method)
+
+		start := time.Now()
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		code := status.Code(err)
+
+		metrics.GRPCClientRequestDuration.WithLabelValues(service, rpc).Observe(time.Since(start).Seconds())
+		metrics.GRPCClientRequests.WithLabelValues(service, rpc, code.String()).Inc()
+		if code == codes.DeadlineExceeded {
+			metrics.GRPCClientTimeouts.WithLabelValues(service, rpc).Inc()
+		}
+
+		return err
+	}
+}
+
+// splitMethod splits "/catalog.CatalogService/GetBookById" into its service and method
+func splitMethod(fullMethod string) (string, string) {
+	fullMethod = strings.TrimPrefix(fullMethod, "/")
+	if i := strings.LastIndex(fullMethod, "/"); i >= 0 {
+		return fullMethod[:i], fullMethod[i+1:]
+	}
+
+	return "unknown", fullMethod
+}
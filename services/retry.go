@@ -0,0 +1,80 @@
+package services
+
+import (
+	"context"
+	"math/rand"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/muhriddinsalohiddin/online_store_api/pkg/metrics"
+)
+
+// idempotentMethods are the RPCs that are safe to send more than once, every
+// Get and List RPC of the services belongs here
+var idempotentMethods = map[string]bool{
+	"/catalog.CatalogService/GetBookById":       true,
+	"/catalog.CatalogService/ListBooks":         true,
+	"/catalog.CatalogService/GetAuthorById":     true,
+	"/catalog.CatalogService/ListAuthors":       true,
+	"/catalog.CatalogService/GetCategoryById":   true,
+	"/catalog.CatalogService/ListCategories":    true,
+	"/order.OrderService/GetOrderById":          true,
+	"/order.OrderService/GetOrderStatusHistory": true,
+	"/order.OrderService/ListOrders":            true,
+}
+
+// retryableCodes are the codes that mean the call never reached the backend
+// or the backend asked us to come back later
+var retryableCodes = map[codes.Code]bool{
+	codes.Unavailable:       true,
+	codes.ResourceExhausted: true,
+}
+
+type retryOptions struct {
+	max        int
+	backoff    time.Duration
+	backoffMax time.Duration
+}
+
+// retryInterceptor retries idempotent calls with exponential backoff and full jitter
+func retryInterceptor(opts retryOptions) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, callOpts ...grpc.CallOption) error {
+		if !idempotentMethods[method] || opts.max <= 0 {
+			return invoker(ctx, method, req, reply, cc, callOpts...)
+		}
+
+		var err error
+		for attempt := 0; ; attempt++ {
+			err = invoker(ctx, method, req, reply, cc, callOpts...)
+			if err == nil || attempt >= opts.max || !retryableCodes[status.Code(err)] {
+				return err
+			}
+
+			timer := time.NewTimer(opts.delay(attempt))
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return err
+			case <-timer.C:
+			}
+
+			service, rpc := splitMethod(method)
+			metrics.GRPCClientRetries.WithLabelValues(service, rpc).Inc()
+		}
+	}
+}
+
+func (o retryOptions) delay(attempt int) time.Duration {
+	d := o.backoff << uint(attempt)
+	if d <= 0 || d > o.backoffMax {
+		d = o.backoffMax
+	}
+	if d <= 0 {
+		return 0
+	}
+
+	return time.Duration(rand.Int63n(int64(d)))
+}
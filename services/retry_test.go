@@ -0,0 +1,135 @@
+package services
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+
+	_ "github.com/muhriddinsalohiddin/online_store_api/genproto/order_service"
+)
+
+func TestRetryInterceptor(t *testing.T) {
+	const (
+		idempotent    = "/catalog.CatalogService/GetBookById"
+		nonIdempotent = "/order.OrderService/CreateOrder"
+	)
+
+	tests := []struct {
+		name      string
+		method    string
+		codes     []codes.Code // answers of the successive attempts, the last one repeats
+		max       int
+		wantCalls int
+		wantCode  codes.Code
+	}{
+		{"success is not retried", idempotent, []codes.Code{codes.OK}, 3, 1, codes.OK},
+		{"unavailable is retried until success", idempotent, []codes.Code{codes.Unavailable, codes.Unavailable, codes.OK}, 3, 3, codes.OK},
+		{"resource exhausted is retried", idempotent, []codes.Code{codes.ResourceExhausted, codes.OK}, 3, 2, codes.OK},
+		{"retries stop at max", idempotent, []codes.Code{codes.Unavailable}, 2, 3, codes.Unavailable},
+		{"not found is not retried", idempotent, []codes.Code{codes.NotFound}, 3, 1, codes.NotFound},
+		{"deadline exceeded is not retried", idempotent, []codes.Code{codes.DeadlineExceeded}, 3, 1, codes.DeadlineExceeded},
+		{"internal is not retried", idempotent, []codes.Code{codes.Internal}, 3, 1, codes.Internal},
+		{"non idempotent methods are not retried", nonIdempotent, []codes.Code{codes.Unavailable}, 3, 1, codes.Unavailable},
+		{"zero max disables retries", idempotent, []codes.Code{codes.Unavailable}, 0, 1, codes.Unavailable},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			invoker := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+				code := tt.codes[len(tt.codes)-1]
+				if calls < len(tt.codes) {
+					code = tt.codes[calls]
+				}
+				calls++
+				if code == codes.OK {
+					return nil
+				}
+				return status.Error(code, "backend")
+			}
+
+			interceptor := retryInterceptor(retryOptions{max: tt.max, backoff: time.Microsecond, backoffMax: time.Millisecond})
+			err := interceptor(context.Background(), tt.method, nil, nil, nil, invoker)
+
+			if calls != tt.wantCalls {
+				t.Errorf("calls = %d, want %d", calls, tt.wantCalls)
+			}
+			if status.Code(err) != tt.wantCode {
+				t.Errorf("code = %v, want %v", status.Code(err), tt.wantCode)
+			}
+		})
+	}
+}
+
+func TestRetryInterceptorStopsWhenContextDone(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	calls := 0
+	invoker := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		calls++
+		cancel()
+		return status.Error(codes.Unavailable, "backend")
+	}
+
+	interceptor := retryInterceptor(retryOptions{max: 5, backoff: time.Hour, backoffMax: time.Hour})
+	err := interceptor(ctx, "/catalog.CatalogService/GetBookById", nil, nil, nil, invoker)
+
+	if calls != 1 {
+		t.Errorf("calls = %d, want 1", calls)
+	}
+	if status.Code(err) != codes.Unavailable {
+		t.Errorf("code = %v, want the last backend error", status.Code(err))
+	}
+}
+
+func TestRetryDelay(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    retryOptions
+		attempt int
+		wantMax time.Duration // delays fall in [0, wantMax)
+	}{
+		{"first attempt uses the base backoff", retryOptions{backoff: 100 * time.Millisecond, backoffMax: time.Second}, 0, 100 * time.Millisecond},
+		{"backoff doubles per attempt", retryOptions{backoff: 100 * time.Millisecond, backoffMax: time.Second}, 2, 400 * time.Millisecond},
+		{"backoff is capped", retryOptions{backoff: 100 * time.Millisecond, backoffMax: time.Second}, 5, time.Second},
+		{"overflow is capped", retryOptions{backoff: 100 * time.Millisecond, backoffMax: time.Second}, 70, time.Second},
+		{"zero backoff means no delay", retryOptions{}, 3, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for i := 0; i < 200; i++ {
+				d := tt.opts.delay(tt.attempt)
+				if d < 0 || (tt.wantMax == 0 && d != 0) || (tt.wantMax > 0 && d >= tt.wantMax) {
+					t.Fatalf("delay(%d) = %v, want in [0, %v)", tt.attempt, d, tt.wantMax)
+				}
+			}
+		})
+	}
+}
+
+func TestReadMethodsAreIdempotent(t *testing.T) {
+	for _, name := range []protoreflect.FullName{"catalog.CatalogService", "order.OrderService"} {
+		desc, err := protoregistry.GlobalFiles.FindDescriptorByName(name)
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+
+		methods := desc.(protoreflect.ServiceDescriptor).Methods()
+		for i := 0; i < methods.Len(); i++ {
+			method := string(methods.Get(i).Name())
+			if !strings.HasPrefix(method, "Get") && !strings.HasPrefix(method, "List") {
+				continue
+			}
+			if full := "/" + string(name) + "/" + method; !idempotentMethods[full] {
+				t.Errorf("read RPC %s is missing from idempotentMethods", full)
+			}
+		}
+	}
+}
@@ -1,8 +1,11 @@
 package services
 
 import (
+	"context"
 	"fmt"
+	"time"
 
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/resolver"
@@ -10,16 +13,27 @@ import (
 	"github.com/muhriddinsalohiddin/online_store_api/config"
 	pbCatalog "github.com/muhriddinsalohiddin/online_store_api/genproto/catalog_service"
 	pbOrder "github.com/muhriddinsalohiddin/online_store_api/genproto/order_service"
+	"github.com/muhriddinsalohiddin/online_store_api/pkg/cache"
+)
+
+const (
+	catalogServiceName = "catalog_service"
+	orderServiceName   = "order_service"
 )
 
 type IServiceManager interface {
 	CatalogService() pbCatalog.CatalogServiceClient
 	OrderService() pbOrder.OrderServiceClient
+	Check(ctx context.Context) map[string]DependencyStatus
+	Close() error
 }
 
 type serviceManager struct {
 	catalogService pbCatalog.CatalogServiceClient
 	orderService   pbOrder.OrderServiceClient
+
+	conns map[string]*grpc.ClientConn
+	cache cache.Cache
 }
 
 func (s *serviceManager) CatalogService() pbCatalog.CatalogServiceClient {
@@ -30,26 +44,79 @@ func (s *serviceManager) OrderService() pbOrder.OrderServiceClient {
 	return s.orderService
 }
 
+// Close tears down every backend connection
+func (s *serviceManager) Close() error {
+	var firstErr error
+	for name, conn := range s.conns {
+		if err := conn.Close(); err != nil && firstErr == nil {
+			firstErr = fmt.Errorf("closing %s connection: %w", name, err)
+		}
+	}
+	if s.cache != nil {
+		if err := s.cache.Close(); err != nil && firstErr == nil {
+			firstErr = fmt.Errorf("closing cache: %w", err)
+		}
+	}
+
+	return firstErr
+}
+
 func NewServiceManager(conf *config.Config) (IServiceManager, error) {
 	resolver.SetDefaultScheme("dns")
 
-	connCatalog, err := grpc.Dial(
-		fmt.Sprintf("%s:%d", conf.CatalogServiceHost, conf.CatalogServicePort),
-		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	connCatalog, err := dial(conf, catalogServiceName, conf.CatalogServiceHost, conf.CatalogServicePort)
 	if err != nil {
 		return nil, err
 	}
-	connOrder, err := grpc.Dial(
-		fmt.Sprintf("%s:%d", conf.OrderServiceHost, conf.OrderServicePort),
-		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	connOrder, err := dial(conf, orderServiceName, conf.OrderServiceHost, conf.OrderServicePort)
 	if err != nil {
+		connCatalog.Close()
+		return nil, err
+	}
+	catalogCache, err := cache.New(conf)
+	if err != nil {
+		connCatalog.Close()
+		connOrder.Close()
 		return nil, err
 	}
 
 	serviceManager := &serviceManager{
 		catalogService: pbCatalog.NewCatalogServiceClient(connCatalog),
 		orderService:   pbOrder.NewOrderServiceClient(connOrder),
+		conns: map[string]*grpc.ClientConn{
+			catalogServiceName: connCatalog,
+			orderServiceName:   connOrder,
+		},
+		cache: catalogCache,
+	}
+	if catalogCache != nil {
+		serviceManager.catalogService = newCachedCatalogClient(serviceManager.catalogService, catalogCache, cacheTTLs{
+			book:     time.Second * time.Duration(conf.CacheTTLBook),
+			author:   time.Second * time.Duration(conf.CacheTTLAuthor),
+			category: time.Second * time.Duration(conf.CacheTTLCategory),
+		}, time.Second*time.Duration(conf.CtxTimeout))
 	}
 
 	return serviceManager, nil
 }
+
+// dial opens a client connection to one backend, each backend gets its own circuit breaker.
+// The tracing interceptor comes first so a client span covers breaker rejections and retries.
+func dial(conf *config.Config, name, host string, port int) (*grpc.ClientConn, error) {
+	breaker := newCircuitBreaker(name, conf.CircuitBreakerFailures, time.Second*time.Duration(conf.CircuitBreakerTimeout))
+
+	return grpc.Dial(
+		fmt.Sprintf("%s:%d", host, port),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithChainUnaryInterceptor(
+			otelgrpc.UnaryClientInterceptor(),
+			metricsInterceptor(),
+			breaker.interceptor(),
+			retryInterceptor(retryOptions{
+				max:        conf.GRPCRetryMax,
+				backoff:    time.Millisecond * time.Duration(conf.GRPCRetryBackoff),
+				backoffMax: time.Millisecond * time.Duration(conf.GRPCRetryBackoffMax),
+			}),
+		),
+	)
+}